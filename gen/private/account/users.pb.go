@@ -25,6 +25,7 @@ type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +74,13 @@ func (x *User) GetEmail() string {
 	return ""
 }
 
+func (x *User) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type GetUsersByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -117,6 +125,138 @@ func (x *GetUsersByIDsRequest) GetIds() []int64 {
 	return nil
 }
 
+type GetUserByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByEmailRequest) Reset() {
+	*x = GetUserByEmailRequest{}
+	mi := &file_account_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByEmailRequest) ProtoMessage() {}
+
+func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_account_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetUserByCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByCalendarFeedTokenRequest) Reset() {
+	*x = GetUserByCalendarFeedTokenRequest{}
+	mi := &file_account_users_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *GetUserByCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_users_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_account_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserByCalendarFeedTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	mi := &file_account_users_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_users_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_account_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 type UsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -126,7 +266,7 @@ type UsersResponse struct {
 
 func (x *UsersResponse) Reset() {
 	*x = UsersResponse{}
-	mi := &file_account_users_proto_msgTypes[2]
+	mi := &file_account_users_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +278,7 @@ func (x *UsersResponse) String() string {
 func (*UsersResponse) ProtoMessage() {}
 
 func (x *UsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_account_users_proto_msgTypes[2]
+	mi := &file_account_users_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +291,7 @@ func (x *UsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsersResponse.ProtoReflect.Descriptor instead.
 func (*UsersResponse) Descriptor() ([]byte, []int) {
-	return file_account_users_proto_rawDescGZIP(), []int{2}
+	return file_account_users_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UsersResponse) GetUsers() []*User {
@@ -166,16 +306,25 @@ var File_account_users_proto protoreflect.FileDescriptor
 const file_account_users_proto_rawDesc = "" +
 	"\n" +
 	"\x13account/users.proto\x12\n" +
-	"account.v1\",\n" +
+	"account.v1\"I\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\"(\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"(\n" +
 	"\x14GetUsersByIDsRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\x03R\x03ids\"7\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"-\n" +
+	"\x15GetUserByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"9\n" +
+	"!GetUserByCalendarFeedTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"4\n" +
+	"\fUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.account.v1.UserR\x04user\"7\n" +
 	"\rUsersResponse\x12&\n" +
-	"\x05users\x18\x01 \x03(\v2\x10.account.v1.UserR\x05users2\\\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.account.v1.UserR\x05users2\x92\x02\n" +
 	"\fUsersService\x12L\n" +
-	"\rGetUsersByIDs\x12 .account.v1.GetUsersByIDsRequest\x1a\x19.account.v1.UsersResponseB,Z*task-tracker/gen/private/account;accountpbb\x06proto3"
+	"\rGetUsersByIDs\x12 .account.v1.GetUsersByIDsRequest\x1a\x19.account.v1.UsersResponse\x12M\n" +
+	"\x0eGetUserByEmail\x12!.account.v1.GetUserByEmailRequest\x1a\x18.account.v1.UserResponse\x12e\n" +
+	"\x1aGetUserByCalendarFeedToken\x12-.account.v1.GetUserByCalendarFeedTokenRequest\x1a\x18.account.v1.UserResponseB,Z*task-tracker/gen/private/account;accountpbb\x06proto3"
 
 var (
 	file_account_users_proto_rawDescOnce sync.Once
@@ -189,21 +338,29 @@ func file_account_users_proto_rawDescGZIP() []byte {
 	return file_account_users_proto_rawDescData
 }
 
-var file_account_users_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_account_users_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_account_users_proto_goTypes = []any{
-	(*User)(nil),                 // 0: account.v1.User
-	(*GetUsersByIDsRequest)(nil), // 1: account.v1.GetUsersByIDsRequest
-	(*UsersResponse)(nil),        // 2: account.v1.UsersResponse
+	(*User)(nil),                              // 0: account.v1.User
+	(*GetUsersByIDsRequest)(nil),              // 1: account.v1.GetUsersByIDsRequest
+	(*GetUserByEmailRequest)(nil),             // 2: account.v1.GetUserByEmailRequest
+	(*GetUserByCalendarFeedTokenRequest)(nil), // 3: account.v1.GetUserByCalendarFeedTokenRequest
+	(*UserResponse)(nil),                      // 4: account.v1.UserResponse
+	(*UsersResponse)(nil),                     // 5: account.v1.UsersResponse
 }
 var file_account_users_proto_depIdxs = []int32{
-	0, // 0: account.v1.UsersResponse.users:type_name -> account.v1.User
-	1, // 1: account.v1.UsersService.GetUsersByIDs:input_type -> account.v1.GetUsersByIDsRequest
-	2, // 2: account.v1.UsersService.GetUsersByIDs:output_type -> account.v1.UsersResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: account.v1.UserResponse.user:type_name -> account.v1.User
+	0, // 1: account.v1.UsersResponse.users:type_name -> account.v1.User
+	1, // 2: account.v1.UsersService.GetUsersByIDs:input_type -> account.v1.GetUsersByIDsRequest
+	2, // 3: account.v1.UsersService.GetUserByEmail:input_type -> account.v1.GetUserByEmailRequest
+	3, // 4: account.v1.UsersService.GetUserByCalendarFeedToken:input_type -> account.v1.GetUserByCalendarFeedTokenRequest
+	5, // 5: account.v1.UsersService.GetUsersByIDs:output_type -> account.v1.UsersResponse
+	4, // 6: account.v1.UsersService.GetUserByEmail:output_type -> account.v1.UserResponse
+	4, // 7: account.v1.UsersService.GetUserByCalendarFeedToken:output_type -> account.v1.UserResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_account_users_proto_init() }
@@ -217,7 +374,7 @@ func file_account_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_users_proto_rawDesc), len(file_account_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
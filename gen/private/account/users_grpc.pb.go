@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersService_GetUsersByIDs_FullMethodName = "/account.v1.UsersService/GetUsersByIDs"
+	UsersService_GetUsersByIDs_FullMethodName              = "/account.v1.UsersService/GetUsersByIDs"
+	UsersService_GetUserByEmail_FullMethodName             = "/account.v1.UsersService/GetUserByEmail"
+	UsersService_GetUserByCalendarFeedToken_FullMethodName = "/account.v1.UsersService/GetUserByCalendarFeedToken"
 )
 
 // UsersServiceClient is the client API for UsersService service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UsersServiceClient interface {
 	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUserByCalendarFeedToken(ctx context.Context, in *GetUserByCalendarFeedTokenRequest, opts ...grpc.CallOption) (*UserResponse, error)
 }
 
 type usersServiceClient struct {
@@ -47,11 +51,33 @@ func (c *usersServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByID
 	return out, nil
 }
 
+func (c *usersServiceClient) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UsersService_GetUserByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUserByCalendarFeedToken(ctx context.Context, in *GetUserByCalendarFeedTokenRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UsersService_GetUserByCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility.
 type UsersServiceServer interface {
 	GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*UsersResponse, error)
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error)
+	GetUserByCalendarFeedToken(context.Context, *GetUserByCalendarFeedTokenRequest) (*UserResponse, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -65,6 +91,12 @@ type UnimplementedUsersServiceServer struct{}
 func (UnimplementedUsersServiceServer) GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*UsersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUsersByIDs not implemented")
 }
+func (UnimplementedUsersServiceServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUserByCalendarFeedToken(context.Context, *GetUserByCalendarFeedTokenRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserByCalendarFeedToken not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 func (UnimplementedUsersServiceServer) testEmbeddedByValue()                      {}
 
@@ -104,6 +136,42 @@ func _UsersService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUserByCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_GetUserByCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByCalendarFeedToken(ctx, req.(*GetUserByCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +183,14 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsersByIDs",
 			Handler:    _UsersService_GetUsersByIDs_Handler,
 		},
+		{
+			MethodName: "GetUserByEmail",
+			Handler:    _UsersService_GetUserByEmail_Handler,
+		},
+		{
+			MethodName: "GetUserByCalendarFeedToken",
+			Handler:    _UsersService_GetUserByCalendarFeedToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "account/users.proto",
@@ -25,18 +25,34 @@ var File_scheduler_scheduler_proto protoreflect.FileDescriptor
 
 const file_scheduler_scheduler_proto_rawDesc = "" +
 	"\n" +
-	"\x19scheduler/scheduler.proto\x12\fscheduler.v1\x1a\x1bgoogle/protobuf/empty.proto2Z\n" +
+	"\x19scheduler/scheduler.proto\x12\fscheduler.v1\x1a\x1bgoogle/protobuf/empty.proto2\xb0\x03\n" +
 	"\x10SchedulerService\x12F\n" +
-	"\x14ProcessRecentExpired\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyB0Z.task-tracker/gen/private/scheduler;schedulerpbb\x06proto3"
+	"\x14ProcessRecentExpired\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x14MaterializeRecurring\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x10SendDueReminders\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\n" +
+	"PurgeTrash\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x14PurgeIdempotencyKeys\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x10PurgeAttachments\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyB0Z.task-tracker/gen/private/scheduler;schedulerpbb\x06proto3"
 
 var file_scheduler_scheduler_proto_goTypes = []any{
 	(*emptypb.Empty)(nil), // 0: google.protobuf.Empty
 }
 var file_scheduler_scheduler_proto_depIdxs = []int32{
 	0, // 0: scheduler.v1.SchedulerService.ProcessRecentExpired:input_type -> google.protobuf.Empty
-	0, // 1: scheduler.v1.SchedulerService.ProcessRecentExpired:output_type -> google.protobuf.Empty
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	0, // 1: scheduler.v1.SchedulerService.MaterializeRecurring:input_type -> google.protobuf.Empty
+	0, // 2: scheduler.v1.SchedulerService.SendDueReminders:input_type -> google.protobuf.Empty
+	0, // 3: scheduler.v1.SchedulerService.PurgeTrash:input_type -> google.protobuf.Empty
+	0, // 4: scheduler.v1.SchedulerService.PurgeIdempotencyKeys:input_type -> google.protobuf.Empty
+	0, // 5: scheduler.v1.SchedulerService.PurgeAttachments:input_type -> google.protobuf.Empty
+	0, // 6: scheduler.v1.SchedulerService.ProcessRecentExpired:output_type -> google.protobuf.Empty
+	0, // 7: scheduler.v1.SchedulerService.MaterializeRecurring:output_type -> google.protobuf.Empty
+	0, // 8: scheduler.v1.SchedulerService.SendDueReminders:output_type -> google.protobuf.Empty
+	0, // 9: scheduler.v1.SchedulerService.PurgeTrash:output_type -> google.protobuf.Empty
+	0, // 10: scheduler.v1.SchedulerService.PurgeIdempotencyKeys:output_type -> google.protobuf.Empty
+	0, // 11: scheduler.v1.SchedulerService.PurgeAttachments:output_type -> google.protobuf.Empty
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -21,6 +21,11 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SchedulerService_ProcessRecentExpired_FullMethodName = "/scheduler.v1.SchedulerService/ProcessRecentExpired"
+	SchedulerService_MaterializeRecurring_FullMethodName = "/scheduler.v1.SchedulerService/MaterializeRecurring"
+	SchedulerService_SendDueReminders_FullMethodName     = "/scheduler.v1.SchedulerService/SendDueReminders"
+	SchedulerService_PurgeTrash_FullMethodName           = "/scheduler.v1.SchedulerService/PurgeTrash"
+	SchedulerService_PurgeIdempotencyKeys_FullMethodName = "/scheduler.v1.SchedulerService/PurgeIdempotencyKeys"
+	SchedulerService_PurgeAttachments_FullMethodName     = "/scheduler.v1.SchedulerService/PurgeAttachments"
 )
 
 // SchedulerServiceClient is the client API for SchedulerService service.
@@ -28,6 +33,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SchedulerServiceClient interface {
 	ProcessRecentExpired(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MaterializeRecurring(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendDueReminders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeTrash(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeIdempotencyKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeAttachments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type schedulerServiceClient struct {
@@ -48,11 +58,66 @@ func (c *schedulerServiceClient) ProcessRecentExpired(ctx context.Context, in *e
 	return out, nil
 }
 
+func (c *schedulerServiceClient) MaterializeRecurring(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SchedulerService_MaterializeRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) SendDueReminders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SchedulerService_SendDueReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) PurgeTrash(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SchedulerService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) PurgeIdempotencyKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SchedulerService_PurgeIdempotencyKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) PurgeAttachments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SchedulerService_PurgeAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerServiceServer is the server API for SchedulerService service.
 // All implementations must embed UnimplementedSchedulerServiceServer
 // for forward compatibility.
 type SchedulerServiceServer interface {
 	ProcessRecentExpired(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	MaterializeRecurring(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	SendDueReminders(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	PurgeTrash(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	PurgeIdempotencyKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	PurgeAttachments(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedSchedulerServiceServer()
 }
 
@@ -66,6 +131,21 @@ type UnimplementedSchedulerServiceServer struct{}
 func (UnimplementedSchedulerServiceServer) ProcessRecentExpired(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ProcessRecentExpired not implemented")
 }
+func (UnimplementedSchedulerServiceServer) MaterializeRecurring(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MaterializeRecurring not implemented")
+}
+func (UnimplementedSchedulerServiceServer) SendDueReminders(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendDueReminders not implemented")
+}
+func (UnimplementedSchedulerServiceServer) PurgeTrash(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedSchedulerServiceServer) PurgeIdempotencyKeys(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeIdempotencyKeys not implemented")
+}
+func (UnimplementedSchedulerServiceServer) PurgeAttachments(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeAttachments not implemented")
+}
 func (UnimplementedSchedulerServiceServer) mustEmbedUnimplementedSchedulerServiceServer() {}
 func (UnimplementedSchedulerServiceServer) testEmbeddedByValue()                          {}
 
@@ -105,6 +185,96 @@ func _SchedulerService_ProcessRecentExpired_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerService_MaterializeRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).MaterializeRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_MaterializeRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).MaterializeRecurring(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_SendDueReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).SendDueReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_SendDueReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).SendDueReminders(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).PurgeTrash(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_PurgeIdempotencyKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).PurgeIdempotencyKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_PurgeIdempotencyKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).PurgeIdempotencyKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_PurgeAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).PurgeAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_PurgeAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).PurgeAttachments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SchedulerService_ServiceDesc is the grpc.ServiceDesc for SchedulerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -116,6 +286,26 @@ var SchedulerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessRecentExpired",
 			Handler:    _SchedulerService_ProcessRecentExpired_Handler,
 		},
+		{
+			MethodName: "MaterializeRecurring",
+			Handler:    _SchedulerService_MaterializeRecurring_Handler,
+		},
+		{
+			MethodName: "SendDueReminders",
+			Handler:    _SchedulerService_SendDueReminders_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _SchedulerService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "PurgeIdempotencyKeys",
+			Handler:    _SchedulerService_PurgeIdempotencyKeys_Handler,
+		},
+		{
+			MethodName: "PurgeAttachments",
+			Handler:    _SchedulerService_PurgeAttachments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "scheduler/scheduler.proto",
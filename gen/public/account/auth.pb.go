@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,6 +28,7 @@ type RegisterRequest struct {
 	Email          string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password       string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	RepeatPassword string                 `protobuf:"bytes,3,opt,name=repeat_password,json=repeatPassword,proto3" json:"repeat_password,omitempty"`
+	TimeZone       string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -82,6 +84,13 @@ func (x *RegisterRequest) GetRepeatPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -134,6 +143,58 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+type SetTimeZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimeZoneRequest) Reset() {
+	*x = SetTimeZoneRequest{}
+	mi := &file_account_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimeZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimeZoneRequest) ProtoMessage() {}
+
+func (x *SetTimeZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimeZoneRequest.ProtoReflect.Descriptor instead.
+func (*SetTimeZoneRequest) Descriptor() ([]byte, []int) {
+	return file_account_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetTimeZoneRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *SetTimeZoneRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type AuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
@@ -143,7 +204,7 @@ type AuthResponse struct {
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_account_auth_proto_msgTypes[2]
+	mi := &file_account_auth_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +216,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_account_auth_proto_msgTypes[2]
+	mi := &file_account_auth_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +229,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_account_auth_proto_rawDescGZIP(), []int{2}
+	return file_account_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AuthResponse) GetJwt() string {
@@ -178,24 +239,170 @@ func (x *AuthResponse) GetJwt() string {
 	return ""
 }
 
+type RotateCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedRequest) Reset() {
+	*x = RotateCalendarFeedRequest{}
+	mi := &file_account_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RotateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_account_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RotateCalendarFeedRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type RevokeCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_account_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_account_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeCalendarFeedRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type CalendarFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the secret part of the feed URL /v1/calendar/{token}/tasks.ics.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeedResponse) Reset() {
+	*x = CalendarFeedResponse{}
+	mi := &file_account_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeedResponse) ProtoMessage() {}
+
+func (x *CalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_account_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalendarFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 var File_account_auth_proto protoreflect.FileDescriptor
 
 const file_account_auth_proto_rawDesc = "" +
 	"\n" +
 	"\x12account/auth.proto\x12\n" +
-	"account.v1\x1a\x1cgoogle/api/annotations.proto\"l\n" +
+	"account.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x89\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12'\n" +
-	"\x0frepeat_password\x18\x03 \x01(\tR\x0erepeatPassword\"@\n" +
+	"\x0frepeat_password\x18\x03 \x01(\tR\x0erepeatPassword\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\" \n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"C\n" +
+	"\x12SetTimeZoneRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\" \n" +
 	"\fAuthResponse\x12\x10\n" +
-	"\x03jwt\x18\x01 \x01(\tR\x03jwt2\xc6\x01\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"-\n" +
+	"\x19RotateCalendarFeedRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"-\n" +
+	"\x19RevokeCalendarFeedRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\",\n" +
+	"\x14CalendarFeedResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token2\xaf\x04\n" +
 	"\vAuthService\x12_\n" +
 	"\bRegister\x12\x1b.account.v1.RegisterRequest\x1a\x18.account.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12V\n" +
-	"\x05Login\x12\x18.account.v1.LoginRequest\x1a\x18.account.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/loginB+Z)task-tracker/gen/public/account;accountpbb\x06proto3"
+	"\x05Login\x12\x18.account.v1.LoginRequest\x1a\x18.account.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12i\n" +
+	"\vSetTimeZone\x12\x1e.account.v1.SetTimeZoneRequest\x1a\x18.account.v1.AuthResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/account/time_zone\x12\x83\x01\n" +
+	"\x12RotateCalendarFeed\x12%.account.v1.RotateCalendarFeedRequest\x1a .account.v1.CalendarFeedResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/account/calendar_feed\x12v\n" +
+	"\x12RevokeCalendarFeed\x12%.account.v1.RevokeCalendarFeedRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/account/calendar_feedB+Z)task-tracker/gen/public/account;accountpbb\x06proto3"
 
 var (
 	file_account_auth_proto_rawDescOnce sync.Once
@@ -209,19 +416,30 @@ func file_account_auth_proto_rawDescGZIP() []byte {
 	return file_account_auth_proto_rawDescData
 }
 
-var file_account_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_account_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_account_auth_proto_goTypes = []any{
-	(*RegisterRequest)(nil), // 0: account.v1.RegisterRequest
-	(*LoginRequest)(nil),    // 1: account.v1.LoginRequest
-	(*AuthResponse)(nil),    // 2: account.v1.AuthResponse
+	(*RegisterRequest)(nil),           // 0: account.v1.RegisterRequest
+	(*LoginRequest)(nil),              // 1: account.v1.LoginRequest
+	(*SetTimeZoneRequest)(nil),        // 2: account.v1.SetTimeZoneRequest
+	(*AuthResponse)(nil),              // 3: account.v1.AuthResponse
+	(*RotateCalendarFeedRequest)(nil), // 4: account.v1.RotateCalendarFeedRequest
+	(*RevokeCalendarFeedRequest)(nil), // 5: account.v1.RevokeCalendarFeedRequest
+	(*CalendarFeedResponse)(nil),      // 6: account.v1.CalendarFeedResponse
+	(*emptypb.Empty)(nil),             // 7: google.protobuf.Empty
 }
 var file_account_auth_proto_depIdxs = []int32{
 	0, // 0: account.v1.AuthService.Register:input_type -> account.v1.RegisterRequest
 	1, // 1: account.v1.AuthService.Login:input_type -> account.v1.LoginRequest
-	2, // 2: account.v1.AuthService.Register:output_type -> account.v1.AuthResponse
-	2, // 3: account.v1.AuthService.Login:output_type -> account.v1.AuthResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: account.v1.AuthService.SetTimeZone:input_type -> account.v1.SetTimeZoneRequest
+	4, // 3: account.v1.AuthService.RotateCalendarFeed:input_type -> account.v1.RotateCalendarFeedRequest
+	5, // 4: account.v1.AuthService.RevokeCalendarFeed:input_type -> account.v1.RevokeCalendarFeedRequest
+	3, // 5: account.v1.AuthService.Register:output_type -> account.v1.AuthResponse
+	3, // 6: account.v1.AuthService.Login:output_type -> account.v1.AuthResponse
+	3, // 7: account.v1.AuthService.SetTimeZone:output_type -> account.v1.AuthResponse
+	6, // 8: account.v1.AuthService.RotateCalendarFeed:output_type -> account.v1.CalendarFeedResponse
+	7, // 9: account.v1.AuthService.RevokeCalendarFeed:output_type -> google.protobuf.Empty
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -238,7 +456,7 @@ func file_account_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_auth_proto_rawDesc), len(file_account_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -10,6 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -74,33 +76,6841 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_task_task_proto_rawDescGZIP(), []int{0}
 }
 
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_NONE   TaskPriority = 0
+	TaskPriority_TASK_PRIORITY_LOW    TaskPriority = 1
+	TaskPriority_TASK_PRIORITY_MEDIUM TaskPriority = 2
+	TaskPriority_TASK_PRIORITY_HIGH   TaskPriority = 3
+	TaskPriority_TASK_PRIORITY_URGENT TaskPriority = 4
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_NONE",
+		1: "TASK_PRIORITY_LOW",
+		2: "TASK_PRIORITY_MEDIUM",
+		3: "TASK_PRIORITY_HIGH",
+		4: "TASK_PRIORITY_URGENT",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_NONE":   0,
+		"TASK_PRIORITY_LOW":    1,
+		"TASK_PRIORITY_MEDIUM": 2,
+		"TASK_PRIORITY_HIGH":   3,
+		"TASK_PRIORITY_URGENT": 4,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[1]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{1}
+}
+
+type ProjectRole int32
+
+const (
+	ProjectRole_PROJECT_ROLE_UNSPECIFIED ProjectRole = 0
+	ProjectRole_PROJECT_ROLE_OWNER       ProjectRole = 1
+	ProjectRole_PROJECT_ROLE_EDITOR      ProjectRole = 2
+	ProjectRole_PROJECT_ROLE_VIEWER      ProjectRole = 3
+)
+
+// Enum value maps for ProjectRole.
+var (
+	ProjectRole_name = map[int32]string{
+		0: "PROJECT_ROLE_UNSPECIFIED",
+		1: "PROJECT_ROLE_OWNER",
+		2: "PROJECT_ROLE_EDITOR",
+		3: "PROJECT_ROLE_VIEWER",
+	}
+	ProjectRole_value = map[string]int32{
+		"PROJECT_ROLE_UNSPECIFIED": 0,
+		"PROJECT_ROLE_OWNER":       1,
+		"PROJECT_ROLE_EDITOR":      2,
+		"PROJECT_ROLE_VIEWER":      3,
+	}
+)
+
+func (x ProjectRole) Enum() *ProjectRole {
+	p := new(ProjectRole)
+	*p = x
+	return p
+}
+
+func (x ProjectRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[2].Descriptor()
+}
+
+func (ProjectRole) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[2]
+}
+
+func (x ProjectRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectRole.Descriptor instead.
+func (ProjectRole) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{2}
+}
+
+type TaskEventType int32
+
+const (
+	TaskEventType_TASK_EVENT_TYPE_CREATED  TaskEventType = 0
+	TaskEventType_TASK_EVENT_TYPE_UPDATED  TaskEventType = 1
+	TaskEventType_TASK_EVENT_TYPE_DELETED  TaskEventType = 2
+	TaskEventType_TASK_EVENT_TYPE_RESTORED TaskEventType = 3
+	TaskEventType_TASK_EVENT_TYPE_PURGED   TaskEventType = 4
+)
+
+// Enum value maps for TaskEventType.
+var (
+	TaskEventType_name = map[int32]string{
+		0: "TASK_EVENT_TYPE_CREATED",
+		1: "TASK_EVENT_TYPE_UPDATED",
+		2: "TASK_EVENT_TYPE_DELETED",
+		3: "TASK_EVENT_TYPE_RESTORED",
+		4: "TASK_EVENT_TYPE_PURGED",
+	}
+	TaskEventType_value = map[string]int32{
+		"TASK_EVENT_TYPE_CREATED":  0,
+		"TASK_EVENT_TYPE_UPDATED":  1,
+		"TASK_EVENT_TYPE_DELETED":  2,
+		"TASK_EVENT_TYPE_RESTORED": 3,
+		"TASK_EVENT_TYPE_PURGED":   4,
+	}
+)
+
+func (x TaskEventType) Enum() *TaskEventType {
+	p := new(TaskEventType)
+	*p = x
+	return p
+}
+
+func (x TaskEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskEventType) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[3]
+}
+
+func (x TaskEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEventType.Descriptor instead.
+func (TaskEventType) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{3}
+}
+
+type TaskEventSource int32
+
+const (
+	TaskEventSource_TASK_EVENT_SOURCE_SYSTEM    TaskEventSource = 0
+	TaskEventSource_TASK_EVENT_SOURCE_USER      TaskEventSource = 1
+	TaskEventSource_TASK_EVENT_SOURCE_SCHEDULER TaskEventSource = 2
+)
+
+// Enum value maps for TaskEventSource.
+var (
+	TaskEventSource_name = map[int32]string{
+		0: "TASK_EVENT_SOURCE_SYSTEM",
+		1: "TASK_EVENT_SOURCE_USER",
+		2: "TASK_EVENT_SOURCE_SCHEDULER",
+	}
+	TaskEventSource_value = map[string]int32{
+		"TASK_EVENT_SOURCE_SYSTEM":    0,
+		"TASK_EVENT_SOURCE_USER":      1,
+		"TASK_EVENT_SOURCE_SCHEDULER": 2,
+	}
+)
+
+func (x TaskEventSource) Enum() *TaskEventSource {
+	p := new(TaskEventSource)
+	*p = x
+	return p
+}
+
+func (x TaskEventSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEventSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[4].Descriptor()
+}
+
+func (TaskEventSource) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[4]
+}
+
+func (x TaskEventSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEventSource.Descriptor instead.
+func (TaskEventSource) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{4}
+}
+
+type BatchAction int32
+
+const (
+	BatchAction_BATCH_ACTION_UNSPECIFIED     BatchAction = 0
+	BatchAction_BATCH_ACTION_SET_STATUS      BatchAction = 1
+	BatchAction_BATCH_ACTION_SET_DUE_DATE    BatchAction = 2
+	BatchAction_BATCH_ACTION_MOVE_TO_PROJECT BatchAction = 3
+	BatchAction_BATCH_ACTION_SET_TAGS        BatchAction = 4
+	BatchAction_BATCH_ACTION_DELETE          BatchAction = 5
+)
+
+// Enum value maps for BatchAction.
+var (
+	BatchAction_name = map[int32]string{
+		0: "BATCH_ACTION_UNSPECIFIED",
+		1: "BATCH_ACTION_SET_STATUS",
+		2: "BATCH_ACTION_SET_DUE_DATE",
+		3: "BATCH_ACTION_MOVE_TO_PROJECT",
+		4: "BATCH_ACTION_SET_TAGS",
+		5: "BATCH_ACTION_DELETE",
+	}
+	BatchAction_value = map[string]int32{
+		"BATCH_ACTION_UNSPECIFIED":     0,
+		"BATCH_ACTION_SET_STATUS":      1,
+		"BATCH_ACTION_SET_DUE_DATE":    2,
+		"BATCH_ACTION_MOVE_TO_PROJECT": 3,
+		"BATCH_ACTION_SET_TAGS":        4,
+		"BATCH_ACTION_DELETE":          5,
+	}
+)
+
+func (x BatchAction) Enum() *BatchAction {
+	p := new(BatchAction)
+	*p = x
+	return p
+}
+
+func (x BatchAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[5].Descriptor()
+}
+
+func (BatchAction) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[5]
+}
+
+func (x BatchAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchAction.Descriptor instead.
+func (BatchAction) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{5}
+}
+
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 0
+	BatchMode_BATCH_MODE_BEST_EFFORT    BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_ALL_OR_NOTHING",
+		1: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_ALL_OR_NOTHING": 0,
+		"BATCH_MODE_BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[6].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[6]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{6}
+}
+
+type TaskSortOrder int32
+
+const (
+	TaskSortOrder_TASK_SORT_ORDER_DUE_DATE_ASC    TaskSortOrder = 0
+	TaskSortOrder_TASK_SORT_ORDER_DUE_DATE_DESC   TaskSortOrder = 1
+	TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_ASC  TaskSortOrder = 2
+	TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_DESC TaskSortOrder = 3
+)
+
+// Enum value maps for TaskSortOrder.
+var (
+	TaskSortOrder_name = map[int32]string{
+		0: "TASK_SORT_ORDER_DUE_DATE_ASC",
+		1: "TASK_SORT_ORDER_DUE_DATE_DESC",
+		2: "TASK_SORT_ORDER_CREATED_AT_ASC",
+		3: "TASK_SORT_ORDER_CREATED_AT_DESC",
+	}
+	TaskSortOrder_value = map[string]int32{
+		"TASK_SORT_ORDER_DUE_DATE_ASC":    0,
+		"TASK_SORT_ORDER_DUE_DATE_DESC":   1,
+		"TASK_SORT_ORDER_CREATED_AT_ASC":  2,
+		"TASK_SORT_ORDER_CREATED_AT_DESC": 3,
+	}
+)
+
+func (x TaskSortOrder) Enum() *TaskSortOrder {
+	p := new(TaskSortOrder)
+	*p = x
+	return p
+}
+
+func (x TaskSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[7].Descriptor()
+}
+
+func (TaskSortOrder) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[7]
+}
+
+func (x TaskSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSortOrder.Descriptor instead.
+func (TaskSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{7}
+}
+
+type TransferFormat int32
+
+const (
+	TransferFormat_TRANSFER_FORMAT_UNSPECIFIED TransferFormat = 0
+	TransferFormat_TRANSFER_FORMAT_CSV         TransferFormat = 1
+	TransferFormat_TRANSFER_FORMAT_JSONL       TransferFormat = 2
+)
+
+// Enum value maps for TransferFormat.
+var (
+	TransferFormat_name = map[int32]string{
+		0: "TRANSFER_FORMAT_UNSPECIFIED",
+		1: "TRANSFER_FORMAT_CSV",
+		2: "TRANSFER_FORMAT_JSONL",
+	}
+	TransferFormat_value = map[string]int32{
+		"TRANSFER_FORMAT_UNSPECIFIED": 0,
+		"TRANSFER_FORMAT_CSV":         1,
+		"TRANSFER_FORMAT_JSONL":       2,
+	}
+)
+
+func (x TransferFormat) Enum() *TransferFormat {
+	p := new(TransferFormat)
+	*p = x
+	return p
+}
+
+func (x TransferFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[8].Descriptor()
+}
+
+func (TransferFormat) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[8]
+}
+
+func (x TransferFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferFormat.Descriptor instead.
+func (TransferFormat) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{8}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED     ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE   ImportRowStatus = 2
+	ImportRowStatus_IMPORT_ROW_STATUS_INVALID     ImportRowStatus = 3
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_CREATED",
+		2: "IMPORT_ROW_STATUS_DUPLICATE",
+		3: "IMPORT_ROW_STATUS_INVALID",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_CREATED":     1,
+		"IMPORT_ROW_STATUS_DUPLICATE":   2,
+		"IMPORT_ROW_STATUS_INVALID":     3,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_task_proto_enumTypes[9].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_task_task_proto_enumTypes[9]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{9}
+}
+
 type Task struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status          TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DueDate         int64                  `protobuf:"varint,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Priority        TaskPriority           `protobuf:"varint,7,opt,name=priority,proto3,enum=task.v1.TaskPriority" json:"priority,omitempty"`
+	Tags            []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId        int64                  `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Position        int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	Subtasks        []*Task                `protobuf:"bytes,11,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	Recurrence      string                 `protobuf:"bytes,12,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ReminderMinutes []int32                `protobuf:"varint,13,rep,packed,name=reminder_minutes,json=reminderMinutes,proto3" json:"reminder_minutes,omitempty"`
+	ProjectId       int64                  `protobuf:"varint,14,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssigneeId      int64                  `protobuf:"varint,15,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	DeletedAt       int64                  `protobuf:"varint,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version         int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	BlockedBy       []int64                `protobuf:"varint,18,rep,packed,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	Blocked         bool                   `protobuf:"varint,19,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// board_rank orders the task within its board column; empty until the
+	// task is placed manually.
+	BoardRank     string `protobuf:"bytes,20,opt,name=board_rank,json=boardRank,proto3" json:"board_rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_task_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Task) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_CREATED
+}
+
+func (x *Task) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Task) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Task) GetSubtasks() []*Task {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+func (x *Task) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *Task) GetReminderMinutes() []int32 {
+	if x != nil {
+		return x.ReminderMinutes
+	}
+	return nil
+}
+
+func (x *Task) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *Task) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *Task) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetBlockedBy() []int64 {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *Task) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Task) GetBoardRank() string {
+	if x != nil {
+		return x.BoardRank
+	}
+	return ""
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksRequest) Reset() {
+	*x = GetTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksRequest) ProtoMessage() {}
+
+func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type CreateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate         int64                  `protobuf:"varint,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Priority        TaskPriority           `protobuf:"varint,4,opt,name=priority,proto3,enum=task.v1.TaskPriority" json:"priority,omitempty"`
+	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Recurrence      string                 `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ReminderMinutes []int32                `protobuf:"varint,7,rep,packed,name=reminder_minutes,json=reminderMinutes,proto3" json:"reminder_minutes,omitempty"`
+	DueDay          string                 `protobuf:"bytes,8,opt,name=due_day,json=dueDay,proto3" json:"due_day,omitempty"`
+	ProjectId       int64                  `protobuf:"varint,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// due_text is a due date in words, such as "tomorrow 5pm", "next friday",
+	// "in 3 days" or "через 2 дня", resolved in the caller's time zone. It is
+	// an alternative to due_date and due_day; the resolved timestamp is
+	// returned in the task's due_date.
+	DueText       string `protobuf:"bytes,10,opt,name=due_text,json=dueText,proto3" json:"due_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetReminderMinutes() []int32 {
+	if x != nil {
+		return x.ReminderMinutes
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetDueDay() string {
+	if x != nil {
+		return x.DueDay
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetDueText() string {
+	if x != nil {
+		return x.DueText
+	}
+	return ""
+}
+
+type UpdateTaskStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Jwt    string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id     int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	Reopen bool                   `protobuf:"varint,4,opt,name=reopen,proto3" json:"reopen,omitempty"`
+	// due_date is required when moving an EXPIRED task back to AT_WORK and
+	// must be in the future; it is rejected on any other transition.
+	DueDate         int64 `protobuf:"varint,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// start_timer starts tracking time on the task when it moves to AT_WORK.
+	StartTimer    bool `protobuf:"varint,7,opt,name=start_timer,json=startTimer,proto3" json:"start_timer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskStatusRequest) Reset() {
+	*x = UpdateTaskStatusRequest{}
+	mi := &file_task_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskStatusRequest) ProtoMessage() {}
+
+func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTaskStatusRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *UpdateTaskStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskStatusRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_CREATED
+}
+
+func (x *UpdateTaskStatusRequest) GetReopen() bool {
+	if x != nil {
+		return x.Reopen
+	}
+	return false
+}
+
+func (x *UpdateTaskStatusRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *UpdateTaskStatusRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTaskStatusRequest) GetStartTimer() bool {
+	if x != nil {
+		return x.StartTimer
+	}
+	return false
+}
+
+type UpdateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate         int64                  `protobuf:"varint,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Priority        TaskPriority           `protobuf:"varint,6,opt,name=priority,proto3,enum=task.v1.TaskPriority" json:"priority,omitempty"`
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Recurrence      string                 `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ReminderMinutes []int32                `protobuf:"varint,9,rep,packed,name=reminder_minutes,json=reminderMinutes,proto3" json:"reminder_minutes,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *UpdateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetReminderMinutes() []int32 {
+	if x != nil {
+		return x.ReminderMinutes
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Statuses      []TaskStatus           `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=task.v1.TaskStatus" json:"statuses,omitempty"`
+	DueFrom       int64                  `protobuf:"varint,3,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`
+	DueTo         int64                  `protobuf:"varint,4,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`
+	CreatedFrom   int64                  `protobuf:"varint,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int64                  `protobuf:"varint,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Query         string                 `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	Sort          TaskSortOrder          `protobuf:"varint,8,opt,name=sort,proto3,enum=task.v1.TaskSortOrder" json:"sort,omitempty"`
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priorities    []TaskPriority         `protobuf:"varint,12,rep,packed,name=priorities,proto3,enum=task.v1.TaskPriority" json:"priorities,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,13,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssignedToMe  bool                   `protobuf:"varint,14,opt,name=assigned_to_me,json=assignedToMe,proto3" json:"assigned_to_me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetDueFrom() int64 {
+	if x != nil {
+		return x.DueFrom
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetDueTo() int64 {
+	if x != nil {
+		return x.DueTo
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetSort() TaskSortOrder {
+	if x != nil {
+		return x.Sort
+	}
+	return TaskSortOrder_TASK_SORT_ORDER_DUE_DATE_ASC
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetPriorities() []TaskPriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetAssignedToMe() bool {
+	if x != nil {
+		return x.AssignedToMe
+	}
+	return false
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_task_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Statuses      []TaskStatus           `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=task.v1.TaskStatus" json:"statuses,omitempty"`
+	DueFrom       int64                  `protobuf:"varint,4,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`
+	DueTo         int64                  `protobuf:"varint,5,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`
+	CreatedFrom   int64                  `protobuf:"varint,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int64                  `protobuf:"varint,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priorities    []TaskPriority         `protobuf:"varint,9,rep,packed,name=priorities,proto3,enum=task.v1.TaskPriority" json:"priorities,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,10,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssignedToMe  bool                   `protobuf:"varint,11,opt,name=assigned_to_me,json=assignedToMe,proto3" json:"assigned_to_me,omitempty"`
+	PageSize      int32                  `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchTasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetDueFrom() int64 {
+	if x != nil {
+		return x.DueFrom
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetDueTo() int64 {
+	if x != nil {
+		return x.DueTo
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetPriorities() []TaskPriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetAssignedToMe() bool {
+	if x != nil {
+		return x.AssignedToMe
+	}
+	return false
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type TaskSearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Task  *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Rank  float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// snippet is an HTML-escaped excerpt of the description with the matches
+	// wrapped in <mark> tags.
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSearchHit) Reset() {
+	*x = TaskSearchHit{}
+	mi := &file_task_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSearchHit) ProtoMessage() {}
+
+func (x *TaskSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSearchHit.ProtoReflect.Descriptor instead.
+func (*TaskSearchHit) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskSearchHit) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskSearchHit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TaskSearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*TaskSearchHit       `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_task_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchTasksResponse) GetHits() []*TaskSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchTaskFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []TaskStatus           `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=task.v1.TaskStatus" json:"statuses,omitempty"`
+	DueFrom       int64                  `protobuf:"varint,2,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`
+	DueTo         int64                  `protobuf:"varint,3,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`
+	CreatedFrom   int64                  `protobuf:"varint,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int64                  `protobuf:"varint,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priorities    []TaskPriority         `protobuf:"varint,8,rep,packed,name=priorities,proto3,enum=task.v1.TaskPriority" json:"priorities,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssignedToMe  bool                   `protobuf:"varint,10,opt,name=assigned_to_me,json=assignedToMe,proto3" json:"assigned_to_me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTaskFilter) Reset() {
+	*x = BatchTaskFilter{}
+	mi := &file_task_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTaskFilter) ProtoMessage() {}
+
+func (x *BatchTaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTaskFilter.ProtoReflect.Descriptor instead.
+func (*BatchTaskFilter) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchTaskFilter) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *BatchTaskFilter) GetDueFrom() int64 {
+	if x != nil {
+		return x.DueFrom
+	}
+	return 0
+}
+
+func (x *BatchTaskFilter) GetDueTo() int64 {
+	if x != nil {
+		return x.DueTo
+	}
+	return 0
+}
+
+func (x *BatchTaskFilter) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *BatchTaskFilter) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *BatchTaskFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *BatchTaskFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BatchTaskFilter) GetPriorities() []TaskPriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *BatchTaskFilter) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *BatchTaskFilter) GetAssignedToMe() bool {
+	if x != nil {
+		return x.AssignedToMe
+	}
+	return false
+}
+
+type BatchUpdateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Filter        *BatchTaskFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,4,opt,name=mode,proto3,enum=task.v1.BatchMode" json:"mode,omitempty"`
+	Action        BatchAction            `protobuf:"varint,5,opt,name=action,proto3,enum=task.v1.BatchAction" json:"action,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	Reopen        bool                   `protobuf:"varint,7,opt,name=reopen,proto3" json:"reopen,omitempty"`
+	DueDate       int64                  `protobuf:"varint,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksRequest) Reset() {
+	*x = BatchUpdateTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchUpdateTasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *BatchUpdateTasksRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchUpdateTasksRequest) GetFilter() *BatchTaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchUpdateTasksRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+func (x *BatchUpdateTasksRequest) GetAction() BatchAction {
+	if x != nil {
+		return x.Action
+	}
+	return BatchAction_BATCH_ACTION_UNSPECIFIED
+}
+
+func (x *BatchUpdateTasksRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_CREATED
+}
+
+func (x *BatchUpdateTasksRequest) GetReopen() bool {
+	if x != nil {
+		return x.Reopen
+	}
+	return false
+}
+
+func (x *BatchUpdateTasksRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *BatchUpdateTasksRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *BatchUpdateTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Task          *Task                  `protobuf:"bytes,5,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_task_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchItemResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type BatchUpdateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksResponse) Reset() {
+	*x = BatchUpdateTasksResponse{}
+	mi := &file_task_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpdateTasksResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateTasksResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUpdateTasksResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type AddSubtaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate       int64                  `protobuf:"varint,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Priority      TaskPriority           `protobuf:"varint,5,opt,name=priority,proto3,enum=task.v1.TaskPriority" json:"priority,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubtaskRequest) Reset() {
+	*x = AddSubtaskRequest{}
+	mi := &file_task_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubtaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubtaskRequest) ProtoMessage() {}
+
+func (x *AddSubtaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubtaskRequest.ProtoReflect.Descriptor instead.
+func (*AddSubtaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddSubtaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AddSubtaskRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AddSubtaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddSubtaskRequest) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *AddSubtaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *AddSubtaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ReorderSubtasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	SubtaskIds    []int64                `protobuf:"varint,3,rep,packed,name=subtask_ids,json=subtaskIds,proto3" json:"subtask_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSubtasksRequest) Reset() {
+	*x = ReorderSubtasksRequest{}
+	mi := &file_task_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSubtasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSubtasksRequest) ProtoMessage() {}
+
+func (x *ReorderSubtasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSubtasksRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSubtasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReorderSubtasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ReorderSubtasksRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ReorderSubtasksRequest) GetSubtaskIds() []int64 {
+	if x != nil {
+		return x.SubtaskIds
+	}
+	return nil
+}
+
+type ToggleSubtaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ToggleSubtaskRequest) Reset() {
+	*x = ToggleSubtaskRequest{}
+	mi := &file_task_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleSubtaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleSubtaskRequest) ProtoMessage() {}
+
+func (x *ToggleSubtaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleSubtaskRequest.ProtoReflect.Descriptor instead.
+func (*ToggleSubtaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ToggleSubtaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ToggleSubtaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ToggleSubtaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MoveTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId       int64                  `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MoveTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveTaskRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *MoveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Counts        *ProjectCounts         `protobuf:"bytes,7,opt,name=counts,proto3" json:"counts,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,8,opt,name=role,proto3,enum=task.v1.ProjectRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_task_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Project) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Project) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Project) GetCounts() *ProjectCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Project) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type ProjectCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Open          int32                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Expired       int32                  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectCounts) Reset() {
+	*x = ProjectCounts{}
+	mi := &file_task_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectCounts) ProtoMessage() {}
+
+func (x *ProjectCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectCounts.ProtoReflect.Descriptor instead.
+func (*ProjectCounts) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProjectCounts) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProjectCounts) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *ProjectCounts) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ProjectCounts) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_task_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateProjectRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_task_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetProjectRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProjectsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_task_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListProjectsRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_task_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateProjectRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *UpdateProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_task_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteProjectRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AssignTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	AssigneeId      int64                  `protobuf:"varint,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignTaskRequest) Reset() {
+	*x = AssignTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTaskRequest) ProtoMessage() {}
+
+func (x *AssignTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTaskRequest.ProtoReflect.Descriptor instead.
+func (*AssignTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssignTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AssignTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignTaskRequest) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *AssignTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ProjectMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.ProjectRole" json:"role,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_task_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProjectMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProjectMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+func (x *ProjectMember) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	mi := &file_task_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListProjectMembersRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListProjectMembersRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type AddProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,4,opt,name=role,proto3,enum=task.v1.ProjectRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	mi := &file_task_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddProjectMemberRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AddProjectMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type UpdateProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,4,opt,name=role,proto3,enum=task.v1.ProjectRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectMemberRequest) Reset() {
+	*x = UpdateProjectMemberRequest{}
+	mi := &file_task_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectMemberRequest) ProtoMessage() {}
+
+func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateProjectMemberRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *UpdateProjectMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateProjectMemberRequest) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type RemoveProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectMemberRequest) Reset() {
+	*x = RemoveProjectMemberRequest{}
+	mi := &file_task_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberRequest) ProtoMessage() {}
+
+func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveProjectMemberRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *RemoveProjectMemberRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RemoveProjectMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMemberResponse) Reset() {
+	*x = ProjectMemberResponse{}
+	mi := &file_task_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMemberResponse) ProtoMessage() {}
+
+func (x *ProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*ProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProjectMemberResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ProjectMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ProjectMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMembersResponse) Reset() {
+	*x = ProjectMembersResponse{}
+	mi := &file_task_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMembersResponse) ProtoMessage() {}
+
+func (x *ProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProjectMembersResponse) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryRequest) Reset() {
+	*x = GetTaskHistoryRequest{}
+	mi := &file_task_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryRequest) ProtoMessage() {}
+
+func (x *GetTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTaskHistoryRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_task_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTrashRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *RestoreTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTaskRequest) Reset() {
+	*x = PurgeTaskRequest{}
+	mi := &file_task_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskRequest) ProtoMessage() {}
+
+func (x *PurgeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurgeTaskRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *PurgeTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListTaskBlockersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskBlockersRequest) Reset() {
+	*x = ListTaskBlockersRequest{}
+	mi := &file_task_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskBlockersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskBlockersRequest) ProtoMessage() {}
+
+func (x *ListTaskBlockersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskBlockersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskBlockersRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTaskBlockersRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListTaskBlockersRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type AddTaskBlockerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	BlockerId     int64                  `protobuf:"varint,3,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskBlockerRequest) Reset() {
+	*x = AddTaskBlockerRequest{}
+	mi := &file_task_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskBlockerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskBlockerRequest) ProtoMessage() {}
+
+func (x *AddTaskBlockerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskBlockerRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskBlockerRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddTaskBlockerRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AddTaskBlockerRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *AddTaskBlockerRequest) GetBlockerId() int64 {
+	if x != nil {
+		return x.BlockerId
+	}
+	return 0
+}
+
+type RemoveTaskBlockerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	BlockerId     int64                  `protobuf:"varint,3,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskBlockerRequest) Reset() {
+	*x = RemoveTaskBlockerRequest{}
+	mi := &file_task_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskBlockerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskBlockerRequest) ProtoMessage() {}
+
+func (x *RemoveTaskBlockerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskBlockerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskBlockerRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveTaskBlockerRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *RemoveTaskBlockerRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *RemoveTaskBlockerRequest) GetBlockerId() int64 {
+	if x != nil {
+		return x.BlockerId
+	}
+	return 0
+}
+
+type TimeEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId          int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartedAt       int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt         int64                  `protobuf:"varint,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Note            string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Manual          bool                   `protobuf:"varint,7,opt,name=manual,proto3" json:"manual,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TimeEntry) Reset() {
+	*x = TimeEntry{}
+	mi := &file_task_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEntry) ProtoMessage() {}
+
+func (x *TimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEntry.ProtoReflect.Descriptor instead.
+func (*TimeEntry) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TimeEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *TimeEntry) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type DailyTime struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Day             string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyTime) Reset() {
+	*x = DailyTime{}
+	mi := &file_task_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyTime) ProtoMessage() {}
+
+func (x *DailyTime) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyTime.ProtoReflect.Descriptor instead.
+func (*DailyTime) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DailyTime) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DailyTime) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type StartTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerRequest) Reset() {
+	*x = StartTimerRequest{}
+	mi := &file_task_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerRequest) ProtoMessage() {}
+
+func (x *StartTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerRequest.ProtoReflect.Descriptor instead.
+func (*StartTimerRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StartTimerRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *StartTimerRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *StartTimerRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type StopTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerRequest) Reset() {
+	*x = StopTimerRequest{}
+	mi := &file_task_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerRequest) ProtoMessage() {}
+
+func (x *StopTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerRequest.ProtoReflect.Descriptor instead.
+func (*StopTimerRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StopTimerRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *StopTimerRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type GetRunningTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRunningTimerRequest) Reset() {
+	*x = GetRunningTimerRequest{}
+	mi := &file_task_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRunningTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunningTimerRequest) ProtoMessage() {}
+
+func (x *GetRunningTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunningTimerRequest.ProtoReflect.Descriptor instead.
+func (*GetRunningTimerRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRunningTimerRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type AddTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       int64                  `protobuf:"varint,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTimeEntryRequest) Reset() {
+	*x = AddTimeEntryRequest{}
+	mi := &file_task_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTimeEntryRequest) ProtoMessage() {}
+
+func (x *AddTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddTimeEntryRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AddTimeEntryRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *AddTimeEntryRequest) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AddTimeEntryRequest) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *AddTimeEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DeleteTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeEntryRequest) Reset() {
+	*x = DeleteTimeEntryRequest{}
+	mi := &file_task_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeEntryRequest) ProtoMessage() {}
+
+func (x *DeleteTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteTimeEntryRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteTimeEntryRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *DeleteTimeEntryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListTimeEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeEntriesRequest) Reset() {
+	*x = ListTimeEntriesRequest{}
+	mi := &file_task_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeEntriesRequest) ProtoMessage() {}
+
+func (x *ListTimeEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListTimeEntriesRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListTimeEntriesRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type TimeEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeEntryResponse) Reset() {
+	*x = TimeEntryResponse{}
+	mi := &file_task_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEntryResponse) ProtoMessage() {}
+
+func (x *TimeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEntryResponse.ProtoReflect.Descriptor instead.
+func (*TimeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TimeEntryResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type TimeEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimeEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Days          []*DailyTime           `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,3,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeEntriesResponse) Reset() {
+	*x = TimeEntriesResponse{}
+	mi := &file_task_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEntriesResponse) ProtoMessage() {}
+
+func (x *TimeEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEntriesResponse.ProtoReflect.Descriptor instead.
+func (*TimeEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TimeEntriesResponse) GetEntries() []*TimeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *TimeEntriesResponse) GetDays() []*DailyTime {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *TimeEntriesResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+type GetTimeReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Jwt   string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// from_day and to_day are inclusive days in the user's time zone,
+	// formatted as YYYY-MM-DD.
+	FromDay       string `protobuf:"bytes,2,opt,name=from_day,json=fromDay,proto3" json:"from_day,omitempty"`
+	ToDay         string `protobuf:"bytes,3,opt,name=to_day,json=toDay,proto3" json:"to_day,omitempty"`
+	ProjectId     int64  `protobuf:"varint,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportRequest) Reset() {
+	*x = GetTimeReportRequest{}
+	mi := &file_task_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportRequest) ProtoMessage() {}
+
+func (x *GetTimeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeReportRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTimeReportRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetFromDay() string {
+	if x != nil {
+		return x.FromDay
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetToDay() string {
+	if x != nil {
+		return x.ToDay
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ProjectDailyTime struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Day             string                 `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProjectDailyTime) Reset() {
+	*x = ProjectDailyTime{}
+	mi := &file_task_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectDailyTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDailyTime) ProtoMessage() {}
+
+func (x *ProjectDailyTime) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDailyTime.ProtoReflect.Descriptor instead.
+func (*ProjectDailyTime) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ProjectDailyTime) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ProjectDailyTime) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *ProjectDailyTime) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ProjectTime struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProjectTime) Reset() {
+	*x = ProjectTime{}
+	mi := &file_task_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTime) ProtoMessage() {}
+
+func (x *ProjectTime) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectTime.ProtoReflect.Descriptor instead.
+func (*ProjectTime) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ProjectTime) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ProjectTime) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type TimeReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ProjectDailyTime    `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Projects      []*ProjectTime         `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,3,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeReportResponse) Reset() {
+	*x = TimeReportResponse{}
+	mi := &file_task_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeReportResponse) ProtoMessage() {}
+
+func (x *TimeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeReportResponse.ProtoReflect.Descriptor instead.
+func (*TimeReportResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TimeReportResponse) GetRows() []*ProjectDailyTime {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *TimeReportResponse) GetProjects() []*ProjectTime {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *TimeReportResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+// GetBoardRequest returns the board of project_id, or the caller's personal
+// board of tasks outside any project when project_id is 0.
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ColumnSize    int32                  `protobuf:"varint,3,opt,name=column_size,json=columnSize,proto3" json:"column_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_task_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetBoardRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetBoardRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetBoardRequest) GetColumnSize() int32 {
+	if x != nil {
+		return x.ColumnSize
+	}
+	return 0
+}
+
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        TaskStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_task_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BoardColumn) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_CREATED
+}
+
+func (x *BoardColumn) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BoardColumn) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*BoardColumn         `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardResponse) Reset() {
+	*x = BoardResponse{}
+	mi := &file_task_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardResponse) ProtoMessage() {}
+
+func (x *BoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardResponse.ProtoReflect.Descriptor instead.
+func (*BoardResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BoardResponse) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// MoveTaskOnBoardRequest places the task right below after_id in the column
+// of status, or on top of it when after_id is 0.
+type MoveTaskOnBoardRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Jwt             string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id              int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status          TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	AfterId         int64                  `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Reopen          bool                   `protobuf:"varint,6,opt,name=reopen,proto3" json:"reopen,omitempty"`
+	StartTimer      bool                   `protobuf:"varint,7,opt,name=start_timer,json=startTimer,proto3" json:"start_timer,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveTaskOnBoardRequest) Reset() {
+	*x = MoveTaskOnBoardRequest{}
+	mi := &file_task_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskOnBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskOnBoardRequest) ProtoMessage() {}
+
+func (x *MoveTaskOnBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskOnBoardRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskOnBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MoveTaskOnBoardRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *MoveTaskOnBoardRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveTaskOnBoardRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_CREATED
+}
+
+func (x *MoveTaskOnBoardRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *MoveTaskOnBoardRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *MoveTaskOnBoardRequest) GetReopen() bool {
+	if x != nil {
+		return x.Reopen
+	}
+	return false
+}
+
+func (x *MoveTaskOnBoardRequest) GetStartTimer() bool {
+	if x != nil {
+		return x.StartTimer
+	}
+	return false
+}
+
+// TemplateItem is the blueprint of one task. The task is due due_offset_days
+// after the start day the template is instantiated with.
+type TemplateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	DueOffsetDays int32                  `protobuf:"varint,2,opt,name=due_offset_days,json=dueOffsetDays,proto3" json:"due_offset_days,omitempty"`
+	Priority      TaskPriority           `protobuf:"varint,3,opt,name=priority,proto3,enum=task.v1.TaskPriority" json:"priority,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Subtasks      []*TemplateItem        `protobuf:"bytes,5,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateItem) Reset() {
+	*x = TemplateItem{}
+	mi := &file_task_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateItem) ProtoMessage() {}
+
+func (x *TemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateItem.ProtoReflect.Descriptor instead.
+func (*TemplateItem) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TemplateItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetDueOffsetDays() int32 {
+	if x != nil {
+		return x.DueOffsetDays
+	}
+	return 0
+}
+
+func (x *TemplateItem) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *TemplateItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TemplateItem) GetSubtasks() []*TemplateItem {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type TaskTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// items are only returned by GetTaskTemplate and on create.
+	Items         []*TemplateItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	ItemCount     int32           `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     int64           `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_task_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TaskTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetItems() []*TemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *TaskTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*TemplateItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskTemplateRequest) Reset() {
+	*x = CreateTaskTemplateRequest{}
+	mi := &file_task_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateTaskTemplateRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *CreateTaskTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaskTemplateRequest) GetItems() []*TemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SaveTaskTemplateRequest captures either a task with its subtasks or every
+// task of a project as a new template.
+type SaveTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TaskId        int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveTaskTemplateRequest) Reset() {
+	*x = SaveTaskTemplateRequest{}
+	mi := &file_task_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveTaskTemplateRequest) ProtoMessage() {}
+
+func (x *SaveTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SaveTaskTemplateRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *SaveTaskTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveTaskTemplateRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *SaveTaskTemplateRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ListTaskTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskTemplatesRequest) Reset() {
+	*x = ListTaskTemplatesRequest{}
+	mi := &file_task_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTaskTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListTaskTemplatesRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+type GetTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTemplateRequest) Reset() {
+	*x = GetTaskTemplateRequest{}
+	mi := &file_task_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTemplateRequest) ProtoMessage() {}
+
+func (x *GetTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetTaskTemplateRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *GetTaskTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskTemplateRequest) Reset() {
+	*x = DeleteTaskTemplateRequest{}
+	mi := &file_task_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteTaskTemplateRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteTaskTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type InstantiateTaskTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Jwt   string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// start_day is a YYYY-MM-DD day in the caller's time zone.
+	StartDay      string `protobuf:"bytes,3,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	ProjectId     int64  `protobuf:"varint,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTaskTemplateRequest) Reset() {
+	*x = InstantiateTaskTemplateRequest{}
+	mi := &file_task_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTaskTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InstantiateTaskTemplateRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *InstantiateTaskTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InstantiateTaskTemplateRequest) GetStartDay() string {
+	if x != nil {
+		return x.StartDay
+	}
+	return ""
+}
+
+func (x *InstantiateTaskTemplateRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type TaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplateResponse) Reset() {
+	*x = TaskTemplateResponse{}
+	mi := &file_task_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplateResponse) ProtoMessage() {}
+
+func (x *TaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*TaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TaskTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type TaskTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TaskTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplatesResponse) Reset() {
+	*x = TaskTemplatesResponse{}
+	mi := &file_task_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplatesResponse) ProtoMessage() {}
+
+func (x *TaskTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*TaskTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TaskTemplatesResponse) GetTemplates() []*TaskTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type TaskComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskComment) Reset() {
+	*x = TaskComment{}
+	mi := &file_task_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskComment) ProtoMessage() {}
+
+func (x *TaskComment) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskComment.ProtoReflect.Descriptor instead.
+func (*TaskComment) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TaskComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskComment) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskComment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TaskComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TaskComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TaskComment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListTaskCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskCommentsRequest) Reset() {
+	*x = ListTaskCommentsRequest{}
+	mi := &file_task_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskCommentsRequest) ProtoMessage() {}
+
+func (x *ListTaskCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListTaskCommentsRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListTaskCommentsRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ListTaskCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTaskCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type AddTaskCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskCommentRequest) Reset() {
+	*x = AddTaskCommentRequest{}
+	mi := &file_task_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskCommentRequest) ProtoMessage() {}
+
+func (x *AddTaskCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AddTaskCommentRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AddTaskCommentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *AddTaskCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateTaskCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskCommentRequest) Reset() {
+	*x = UpdateTaskCommentRequest{}
+	mi := &file_task_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskCommentRequest) ProtoMessage() {}
+
+func (x *UpdateTaskCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateTaskCommentRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *UpdateTaskCommentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *UpdateTaskCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteTaskCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskCommentRequest) Reset() {
+	*x = DeleteTaskCommentRequest{}
+	mi := &file_task_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskCommentRequest) ProtoMessage() {}
+
+func (x *DeleteTaskCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteTaskCommentRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteTaskCommentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *DeleteTaskCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TaskCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *TaskComment           `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskCommentResponse) Reset() {
+	*x = TaskCommentResponse{}
+	mi := &file_task_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCommentResponse) ProtoMessage() {}
+
+func (x *TaskCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskCommentResponse.ProtoReflect.Descriptor instead.
+func (*TaskCommentResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TaskCommentResponse) GetComment() *TaskComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type TaskCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*TaskComment         `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskCommentsResponse) Reset() {
+	*x = TaskCommentsResponse{}
+	mi := &file_task_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCommentsResponse) ProtoMessage() {}
+
+func (x *TaskCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskCommentsResponse.ProtoReflect.Descriptor instead.
+func (*TaskCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TaskCommentsResponse) GetComments() []*TaskComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *TaskCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_task_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *Attachment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AttachmentUploadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUploadInfo) Reset() {
+	*x = AttachmentUploadInfo{}
+	mi := &file_task_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUploadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUploadInfo) ProtoMessage() {}
+
+func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUploadInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentUploadInfo) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AttachmentUploadInfo) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *AttachmentUploadInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_task_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentUploadInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_task_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DownloadAttachmentRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_task_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_task_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListAttachmentsRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ListAttachmentsRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_task_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteAttachmentRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *DeleteAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentResponse) Reset() {
+	*x = AttachmentResponse{}
+	mi := &file_task_task_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentResponse) ProtoMessage() {}
+
+func (x *AttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type AttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentsResponse) Reset() {
+	*x = AttachmentsResponse{}
+	mi := &file_task_task_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentsResponse) ProtoMessage() {}
+
+func (x *AttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ExportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Format        TransferFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=task.v1.TransferFormat" json:"format,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksRequest) ProtoMessage() {}
+
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ExportTasksRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetFormat() TransferFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TransferFormat_TRANSFER_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportTasksRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ExportInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInfo) Reset() {
+	*x = ExportInfo{}
+	mi := &file_task_task_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInfo) ProtoMessage() {}
+
+func (x *ExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInfo.ProtoReflect.Descriptor instead.
+func (*ExportInfo) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExportInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ExportTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExportTasksResponse_Info
+	//	*ExportTasksResponse_Chunk
+	Payload       isExportTasksResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksResponse) Reset() {
+	*x = ExportTasksResponse{}
+	mi := &file_task_task_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksResponse) ProtoMessage() {}
+
+func (x *ExportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ExportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportTasksResponse) GetPayload() isExportTasksResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportTasksResponse) GetInfo() *ExportInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportTasksResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *ExportTasksResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportTasksResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isExportTasksResponse_Payload interface {
+	isExportTasksResponse_Payload()
+}
+
+type ExportTasksResponse_Info struct {
+	Info *ExportInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type ExportTasksResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ExportTasksResponse_Info) isExportTasksResponse_Payload() {}
+
+func (*ExportTasksResponse_Chunk) isExportTasksResponse_Payload() {}
+
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_task_task_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCalendarFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GetCalendarFeedResponse_Info
+	//	*GetCalendarFeedResponse_Chunk
+	Payload       isGetCalendarFeedResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_task_task_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetCalendarFeedResponse) GetPayload() isGetCalendarFeedResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetCalendarFeedResponse) GetInfo() *ExportInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*GetCalendarFeedResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *GetCalendarFeedResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*GetCalendarFeedResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isGetCalendarFeedResponse_Payload interface {
+	isGetCalendarFeedResponse_Payload()
+}
+
+type GetCalendarFeedResponse_Info struct {
+	Info *ExportInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type GetCalendarFeedResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*GetCalendarFeedResponse_Info) isGetCalendarFeedResponse_Payload() {}
+
+func (*GetCalendarFeedResponse_Chunk) isGetCalendarFeedResponse_Payload() {}
+
+type ImportTasksInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Format        TransferFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=task.v1.TransferFormat" json:"format,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksInfo) Reset() {
+	*x = ImportTasksInfo{}
+	mi := &file_task_task_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksInfo) ProtoMessage() {}
+
+func (x *ImportTasksInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksInfo.ProtoReflect.Descriptor instead.
+func (*ImportTasksInfo) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ImportTasksInfo) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+func (x *ImportTasksInfo) GetFormat() TransferFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TransferFormat_TRANSFER_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportTasksInfo) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportTasksRequest_Info
+	//	*ImportTasksRequest_Chunk
+	Payload       isImportTasksRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_task_task_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ImportTasksRequest) GetPayload() isImportTasksRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetInfo() *ImportTasksInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTasksRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTasksRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportTasksRequest_Payload interface {
+	isImportTasksRequest_Payload()
+}
+
+type ImportTasksRequest_Info struct {
+	Info *ImportTasksInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type ImportTasksRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportTasksRequest_Info) isImportTasksRequest_Payload() {}
+
+func (*ImportTasksRequest_Chunk) isImportTasksRequest_Payload() {}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Status        ImportRowStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=task.v1.ImportRowStatus" json:"status,omitempty"`
+	TaskId        int64                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_task_task_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	DueDate       int64                  `protobuf:"varint,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Invalid       int32                  `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_task_task_proto_msgTypes[0]
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_task_task_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *ImportTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*ImportTasksResponse) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[0]
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,76 +6921,76 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *Task) GetId() int64 {
+func (x *ImportTasksResponse) GetDryRun() bool {
 	if x != nil {
-		return x.Id
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *Task) GetUserId() int64 {
+func (x *ImportTasksResponse) GetCreated() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Created
 	}
 	return 0
 }
 
-func (x *Task) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Task) GetStatus() TaskStatus {
+func (x *ImportTasksResponse) GetDuplicates() int32 {
 	if x != nil {
-		return x.Status
+		return x.Duplicates
 	}
-	return TaskStatus_TASK_STATUS_CREATED
+	return 0
 }
 
-func (x *Task) GetCreatedAt() int64 {
+func (x *ImportTasksResponse) GetInvalid() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Invalid
 	}
 	return 0
 }
 
-func (x *Task) GetDueDate() int64 {
+func (x *ImportTasksResponse) GetRows() []*ImportRowResult {
 	if x != nil {
-		return x.DueDate
+		return x.Rows
 	}
-	return 0
+	return nil
 }
 
-type GetTaskRequest struct {
+type TaskEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Type          TaskEventType          `protobuf:"varint,3,opt,name=type,proto3,enum=task.v1.TaskEventType" json:"type,omitempty"`
+	Field         string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	ActorId       int64                  `protobuf:"varint,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Source        TaskEventSource        `protobuf:"varint,8,opt,name=source,proto3,enum=task.v1.TaskEventSource" json:"source,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_task_task_proto_msgTypes[1]
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_task_task_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *TaskEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*TaskEvent) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[1]
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,47 +7001,96 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetTaskRequest) GetJwt() string {
+func (x *TaskEvent) GetId() int64 {
 	if x != nil {
-		return x.Jwt
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetType() TaskEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskEventType_TASK_EVENT_TYPE_CREATED
+}
+
+func (x *TaskEvent) GetField() string {
+	if x != nil {
+		return x.Field
 	}
 	return ""
 }
 
-func (x *GetTaskRequest) GetId() int64 {
+func (x *TaskEvent) GetOldValue() string {
 	if x != nil {
-		return x.Id
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
 	}
 	return 0
 }
 
-type GetTasksRequest struct {
+func (x *TaskEvent) GetSource() TaskEventSource {
+	if x != nil {
+		return x.Source
+	}
+	return TaskEventSource_TASK_EVENT_SOURCE_SYSTEM
+}
+
+func (x *TaskEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type TaskHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Events        []*TaskEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksRequest) Reset() {
-	*x = GetTasksRequest{}
-	mi := &file_task_task_proto_msgTypes[2]
+func (x *TaskHistoryResponse) Reset() {
+	*x = TaskHistoryResponse{}
+	mi := &file_task_task_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksRequest) String() string {
+func (x *TaskHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksRequest) ProtoMessage() {}
+func (*TaskHistoryResponse) ProtoMessage() {}
 
-func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[2]
+func (x *TaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,42 +7101,40 @@ func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*TaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetTasksRequest) GetJwt() string {
+func (x *TaskHistoryResponse) GetEvents() []*TaskEvent {
 	if x != nil {
-		return x.Jwt
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type CreateTaskRequest struct {
+type ProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	DueDate       int64                  `protobuf:"varint,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_task_task_proto_msgTypes[3]
+func (x *ProjectResponse) Reset() {
+	*x = ProjectResponse{}
+	mi := &file_task_task_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *ProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*ProjectResponse) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[3]
+func (x *ProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,56 +7145,40 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CreateTaskRequest) GetJwt() string {
-	if x != nil {
-		return x.Jwt
-	}
-	return ""
-}
-
-func (x *CreateTaskRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ProjectResponse.ProtoReflect.Descriptor instead.
+func (*ProjectResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CreateTaskRequest) GetDueDate() int64 {
+func (x *ProjectResponse) GetProject() *Project {
 	if x != nil {
-		return x.DueDate
+		return x.Project
 	}
-	return 0
+	return nil
 }
 
-type UpdateTaskStatusRequest struct {
+type ProjectsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jwt           string                 `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.v1.TaskStatus" json:"status,omitempty"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskStatusRequest) Reset() {
-	*x = UpdateTaskStatusRequest{}
-	mi := &file_task_task_proto_msgTypes[4]
+func (x *ProjectsResponse) Reset() {
+	*x = ProjectsResponse{}
+	mi := &file_task_task_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskStatusRequest) String() string {
+func (x *ProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskStatusRequest) ProtoMessage() {}
+func (*ProjectsResponse) ProtoMessage() {}
 
-func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[4]
+func (x *ProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_task_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,30 +7189,16 @@ func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskStatusRequest) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *UpdateTaskStatusRequest) GetJwt() string {
-	if x != nil {
-		return x.Jwt
-	}
-	return ""
-}
-
-func (x *UpdateTaskStatusRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_task_task_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *UpdateTaskStatusRequest) GetStatus() TaskStatus {
+func (x *ProjectsResponse) GetProjects() []*Project {
 	if x != nil {
-		return x.Status
+		return x.Projects
 	}
-	return TaskStatus_TASK_STATUS_CREATED
+	return nil
 }
 
 type TaskResponse struct {
@@ -383,7 +7210,7 @@ type TaskResponse struct {
 
 func (x *TaskResponse) Reset() {
 	*x = TaskResponse{}
-	mi := &file_task_task_proto_msgTypes[5]
+	mi := &file_task_task_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +7222,7 @@ func (x *TaskResponse) String() string {
 func (*TaskResponse) ProtoMessage() {}
 
 func (x *TaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[5]
+	mi := &file_task_task_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +7235,7 @@ func (x *TaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResponse.ProtoReflect.Descriptor instead.
 func (*TaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{5}
+	return file_task_task_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *TaskResponse) GetTask() *Task {
@@ -427,7 +7254,7 @@ type TasksResponse struct {
 
 func (x *TasksResponse) Reset() {
 	*x = TasksResponse{}
-	mi := &file_task_task_proto_msgTypes[6]
+	mi := &file_task_task_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +7266,7 @@ func (x *TasksResponse) String() string {
 func (*TasksResponse) ProtoMessage() {}
 
 func (x *TasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_task_proto_msgTypes[6]
+	mi := &file_task_task_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +7279,7 @@ func (x *TasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksResponse.ProtoReflect.Descriptor instead.
 func (*TasksResponse) Descriptor() ([]byte, []int) {
-	return file_task_task_proto_rawDescGZIP(), []int{6}
+	return file_task_task_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *TasksResponse) GetTasks() []*Task {
@@ -466,7 +7293,7 @@ var File_task_task_proto protoreflect.FileDescriptor
 
 const file_task_task_proto_rawDesc = "" +
 	"\n" +
-	"\x0ftask/task.proto\x12\atask.v1\x1a\x1cgoogle/api/annotations.proto\"\xb8\x01\n" +
+	"\x0ftask/task.proto\x12\atask.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xff\x04\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12 \n" +
@@ -474,20 +7301,543 @@ const file_task_task_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x19\n" +
-	"\bdue_date\x18\x06 \x01(\x03R\adueDate\"2\n" +
+	"\bdue_date\x18\x06 \x01(\x03R\adueDate\x121\n" +
+	"\bpriority\x18\a \x01(\x0e2\x15.task.v1.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\t \x01(\x03R\bparentId\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\x12)\n" +
+	"\bsubtasks\x18\v \x03(\v2\r.task.v1.TaskR\bsubtasks\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\f \x01(\tR\n" +
+	"recurrence\x12)\n" +
+	"\x10reminder_minutes\x18\r \x03(\x05R\x0freminderMinutes\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x0e \x01(\x03R\tprojectId\x12\x1f\n" +
+	"\vassignee_id\x18\x0f \x01(\x03R\n" +
+	"assigneeId\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\x03R\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\x12 \x03(\x03R\tblockedBy\x12\x18\n" +
+	"\ablocked\x18\x13 \x01(\bR\ablocked\x12\x1d\n" +
+	"\n" +
+	"board_rank\x18\x14 \x01(\tR\tboardRank\"2\n" +
 	"\x0eGetTaskRequest\x12\x10\n" +
 	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x03R\x02id\"#\n" +
 	"\x0fGetTasksRequest\x12\x10\n" +
-	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"b\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"\xc7\x02\n" +
 	"\x11CreateTaskRequest\x12\x10\n" +
 	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\bdue_date\x18\x03 \x01(\x03R\adueDate\"h\n" +
+	"\bdue_date\x18\x03 \x01(\x03R\adueDate\x121\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x15.task.v1.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\tR\n" +
+	"recurrence\x12)\n" +
+	"\x10reminder_minutes\x18\a \x03(\x05R\x0freminderMinutes\x12\x17\n" +
+	"\adue_day\x18\b \x01(\tR\x06dueDay\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\t \x01(\x03R\tprojectId\x12\x19\n" +
+	"\bdue_text\x18\n" +
+	" \x01(\tR\adueText\"\xe7\x01\n" +
 	"\x17UpdateTaskStatusRequest\x12\x10\n" +
 	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x03R\x02id\x12+\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\"1\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\x12\x16\n" +
+	"\x06reopen\x18\x04 \x01(\bR\x06reopen\x12\x19\n" +
+	"\bdue_date\x18\x05 \x01(\x03R\adueDate\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\x12\x1f\n" +
+	"\vstart_timer\x18\a \x01(\bR\n" +
+	"startTimer\"\xec\x02\n" +
+	"\x11UpdateTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\x03R\adueDate\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x121\n" +
+	"\bpriority\x18\x06 \x01(\x0e2\x15.task.v1.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\tR\n" +
+	"recurrence\x12)\n" +
+	"\x10reminder_minutes\x18\t \x03(\x05R\x0freminderMinutes\x12)\n" +
+	"\x10expected_version\x18\n" +
+	" \x01(\x03R\x0fexpectedVersion\"`\n" +
+	"\x11DeleteTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"\xd7\x03\n" +
+	"\x10ListTasksRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12/\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x13.task.v1.TaskStatusR\bstatuses\x12\x19\n" +
+	"\bdue_from\x18\x03 \x01(\x03R\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\x04 \x01(\x03R\x05dueTo\x12!\n" +
+	"\fcreated_from\x18\x05 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\x03R\tcreatedTo\x12\x14\n" +
+	"\x05query\x18\a \x01(\tR\x05query\x12*\n" +
+	"\x04sort\x18\b \x01(\x0e2\x16.task.v1.TaskSortOrderR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x125\n" +
+	"\n" +
+	"priorities\x18\f \x03(\x0e2\x15.task.v1.TaskPriorityR\n" +
+	"priorities\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\r \x01(\x03R\tprojectId\x12$\n" +
+	"\x0eassigned_to_me\x18\x0e \x01(\bR\fassignedToMe\"`\n" +
+	"\x11ListTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xad\x03\n" +
+	"\x12SearchTasksRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12/\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x13.task.v1.TaskStatusR\bstatuses\x12\x19\n" +
+	"\bdue_from\x18\x04 \x01(\x03R\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\x05 \x01(\x03R\x05dueTo\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\x03R\tcreatedTo\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x125\n" +
+	"\n" +
+	"priorities\x18\t \x03(\x0e2\x15.task.v1.TaskPriorityR\n" +
+	"priorities\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\n" +
+	" \x01(\x03R\tprojectId\x12$\n" +
+	"\x0eassigned_to_me\x18\v \x01(\bR\fassignedToMe\x12\x1b\n" +
+	"\tpage_size\x18\f \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\"`\n" +
+	"\rTaskSearchHit\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"i\n" +
+	"\x13SearchTasksResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.task.v1.TaskSearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdc\x02\n" +
+	"\x0fBatchTaskFilter\x12/\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x13.task.v1.TaskStatusR\bstatuses\x12\x19\n" +
+	"\bdue_from\x18\x02 \x01(\x03R\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\x03 \x01(\x03R\x05dueTo\x12!\n" +
+	"\fcreated_from\x18\x04 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\x03R\tcreatedTo\x12\x14\n" +
+	"\x05query\x18\x06 \x01(\tR\x05query\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x125\n" +
+	"\n" +
+	"priorities\x18\b \x03(\x0e2\x15.task.v1.TaskPriorityR\n" +
+	"priorities\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\t \x01(\x03R\tprojectId\x12$\n" +
+	"\x0eassigned_to_me\x18\n" +
+	" \x01(\bR\fassignedToMe\"\xd8\x02\n" +
+	"\x17BatchUpdateTasksRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\x120\n" +
+	"\x06filter\x18\x03 \x01(\v2\x18.task.v1.BatchTaskFilterR\x06filter\x12&\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x12.task.v1.BatchModeR\x04mode\x12,\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x14.task.v1.BatchActionR\x06action\x12+\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\x12\x16\n" +
+	"\x06reopen\x18\a \x01(\bR\x06reopen\x12\x19\n" +
+	"\bdue_date\x18\b \x01(\x03R\adueDate\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\t \x01(\x03R\tprojectId\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\"~\n" +
+	"\x0fBatchItemResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12!\n" +
+	"\x04task\x18\x05 \x01(\v2\r.task.v1.TaskR\x04task\"\x84\x01\n" +
+	"\x18BatchUpdateTasksResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.task.v1.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\xc6\x01\n" +
+	"\x11AddSubtaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\x03R\adueDate\x121\n" +
+	"\bpriority\x18\x05 \x01(\x0e2\x15.task.v1.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"h\n" +
+	"\x16ReorderSubtasksRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x1f\n" +
+	"\vsubtask_ids\x18\x03 \x03(\x03R\n" +
+	"subtaskIds\"c\n" +
+	"\x14ToggleSubtaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"}\n" +
+	"\x0fMoveTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\x03R\tprojectId\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"\xf1\x01\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12.\n" +
+	"\x06counts\x18\a \x01(\v2\x16.task.v1.ProjectCountsR\x06counts\x12(\n" +
+	"\x04role\x18\b \x01(\x0e2\x14.task.v1.ProjectRoleR\x04role\"q\n" +
+	"\rProjectCounts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\x05R\aexpired\"R\n" +
+	"\x14CreateProjectRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"5\n" +
+	"\x11GetProjectRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"R\n" +
+	"\x13ListProjectsRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"\xbb\x01\n" +
+	"\x14UpdateProjectRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"8\n" +
+	"\x14DeleteProjectRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x81\x01\n" +
+	"\x11AssignTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vassignee_id\x18\x03 \x01(\x03R\n" +
+	"assigneeId\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"\x87\x01\n" +
+	"\rProjectMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12(\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x14.task.v1.ProjectRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"L\n" +
+	"\x19ListProjectMembersRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\"\x8a\x01\n" +
+	"\x17AddProjectMemberRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.task.v1.ProjectRoleR\x04role\"\x90\x01\n" +
+	"\x1aUpdateProjectMemberRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.task.v1.ProjectRoleR\x04role\"f\n" +
+	"\x1aRemoveProjectMemberRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"G\n" +
+	"\x15ProjectMemberResponse\x12.\n" +
+	"\x06member\x18\x01 \x01(\v2\x16.task.v1.ProjectMemberR\x06member\"J\n" +
+	"\x16ProjectMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.task.v1.ProjectMemberR\amembers\"9\n" +
+	"\x15GetTaskHistoryRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"$\n" +
+	"\x10ListTrashRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"6\n" +
+	"\x12RestoreTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"4\n" +
+	"\x10PurgeTaskRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"D\n" +
+	"\x17ListTaskBlockersRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"a\n" +
+	"\x15AddTaskBlockerRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1d\n" +
+	"\n" +
+	"blocker_id\x18\x03 \x01(\x03R\tblockerId\"d\n" +
+	"\x18RemoveTaskBlockerRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1d\n" +
+	"\n" +
+	"blocker_id\x18\x03 \x01(\x03R\tblockerId\"\xde\x01\n" +
+	"\tTimeEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x05 \x01(\x03R\aendedAt\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x16\n" +
+	"\x06manual\x18\a \x01(\bR\x06manual\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x03R\x0fdurationSeconds\"H\n" +
+	"\tDailyTime\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\"R\n" +
+	"\x11StartTimerRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"=\n" +
+	"\x10StopTimerRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"*\n" +
+	"\x16GetRunningTimerRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\"\x8e\x01\n" +
+	"\x13AddTimeEntryRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\x03R\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x04 \x01(\x03R\aendedAt\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"S\n" +
+	"\x16DeleteTimeEntryRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"C\n" +
+	"\x16ListTimeEntriesRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"=\n" +
+	"\x11TimeEntryResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.task.v1.TimeEntryR\x05entry\"\x90\x01\n" +
+	"\x13TimeEntriesResponse\x12,\n" +
+	"\aentries\x18\x01 \x03(\v2\x12.task.v1.TimeEntryR\aentries\x12&\n" +
+	"\x04days\x18\x02 \x03(\v2\x12.task.v1.DailyTimeR\x04days\x12#\n" +
+	"\rtotal_seconds\x18\x03 \x01(\x03R\ftotalSeconds\"y\n" +
+	"\x14GetTimeReportRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x19\n" +
+	"\bfrom_day\x18\x02 \x01(\tR\afromDay\x12\x15\n" +
+	"\x06to_day\x18\x03 \x01(\tR\x05toDay\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\x03R\tprojectId\"n\n" +
+	"\x10ProjectDailyTime\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"W\n" +
+	"\vProjectTime\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\"\x9a\x01\n" +
+	"\x12TimeReportResponse\x12-\n" +
+	"\x04rows\x18\x01 \x03(\v2\x19.task.v1.ProjectDailyTimeR\x04rows\x120\n" +
+	"\bprojects\x18\x02 \x03(\v2\x14.task.v1.ProjectTimeR\bprojects\x12#\n" +
+	"\rtotal_seconds\x18\x03 \x01(\x03R\ftotalSeconds\"c\n" +
+	"\x0fGetBoardRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12\x1f\n" +
+	"\vcolumn_size\x18\x03 \x01(\x05R\n" +
+	"columnSize\"u\n" +
+	"\vBoardColumn\x12+\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\x12#\n" +
+	"\x05tasks\x18\x02 \x03(\v2\r.task.v1.TaskR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"?\n" +
+	"\rBoardResponse\x12.\n" +
+	"\acolumns\x18\x01 \x03(\v2\x14.task.v1.BoardColumnR\acolumns\"\xe6\x01\n" +
+	"\x16MoveTaskOnBoardRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.task.v1.TaskStatusR\x06status\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\x03R\aafterId\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\x12\x16\n" +
+	"\x06reopen\x18\x06 \x01(\bR\x06reopen\x12\x1f\n" +
+	"\vstart_timer\x18\a \x01(\bR\n" +
+	"startTimer\"\xd2\x01\n" +
+	"\fTemplateItem\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12&\n" +
+	"\x0fdue_offset_days\x18\x02 \x01(\x05R\rdueOffsetDays\x121\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x15.task.v1.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x121\n" +
+	"\bsubtasks\x18\x05 \x03(\v2\x15.task.v1.TemplateItemR\bsubtasks\"\x9d\x01\n" +
+	"\fTaskTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.task.v1.TemplateItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x04 \x01(\x05R\titemCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"n\n" +
+	"\x19CreateTaskTemplateRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.task.v1.TemplateItemR\x05items\"w\n" +
+	"\x17SaveTaskTemplateRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\x03R\tprojectId\",\n" +
+	"\x18ListTaskTemplatesRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\":\n" +
+	"\x16GetTaskTemplateRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"=\n" +
+	"\x19DeleteTaskTemplateRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"~\n" +
+	"\x1eInstantiateTaskTemplateRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tstart_day\x18\x03 \x01(\tR\bstartDay\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\x03R\tprojectId\"I\n" +
+	"\x14TaskTemplateResponse\x121\n" +
+	"\btemplate\x18\x01 \x01(\v2\x15.task.v1.TaskTemplateR\btemplate\"L\n" +
+	"\x15TaskTemplatesResponse\x123\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x15.task.v1.TaskTemplateR\ttemplates\"\xa1\x01\n" +
+	"\vTaskComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\x80\x01\n" +
+	"\x17ListTaskCommentsRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"V\n" +
+	"\x15AddTaskCommentRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"i\n" +
+	"\x18UpdateTaskCommentRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"U\n" +
+	"\x18DeleteTaskCommentRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"E\n" +
+	"\x13TaskCommentResponse\x12.\n" +
+	"\acomment\x18\x01 \x01(\v2\x14.task.v1.TaskCommentR\acomment\"p\n" +
+	"\x14TaskCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.task.v1.TaskCommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc1\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\x95\x01\n" +
+	"\x14AttachmentUploadInfo\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"q\n" +
+	"\x17UploadAttachmentRequest\x123\n" +
+	"\x04info\x18\x01 \x01(\v2\x1d.task.v1.AttachmentUploadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"V\n" +
+	"\x19DownloadAttachmentRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"v\n" +
+	"\x1aDownloadAttachmentResponse\x125\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.task.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"C\n" +
+	"\x16ListAttachmentsRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"T\n" +
+	"\x17DeleteAttachmentRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"I\n" +
+	"\x12AttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.task.v1.AttachmentR\n" +
+	"attachment\"L\n" +
+	"\x13AttachmentsResponse\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.task.v1.AttachmentR\vattachments\"v\n" +
+	"\x12ExportTasksRequest\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.task.v1.TransferFormatR\x06format\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\x03R\tprojectId\"L\n" +
+	"\n" +
+	"ExportInfo\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"c\n" +
+	"\x13ExportTasksResponse\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.task.v1.ExportInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\".\n" +
+	"\x16GetCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"g\n" +
+	"\x17GetCalendarFeedResponse\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.task.v1.ExportInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"m\n" +
+	"\x0fImportTasksInfo\x12\x10\n" +
+	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.task.v1.TransferFormatR\x06format\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"g\n" +
+	"\x12ImportTasksRequest\x12.\n" +
+	"\x04info\x18\x01 \x01(\v2\x18.task.v1.ImportTasksInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x86\x01\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.task.v1.ImportRowStatusR\x06status\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xb0\x01\n" +
+	"\x13ImportTasksResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x18\n" +
+	"\ainvalid\x18\x04 \x01(\x05R\ainvalid\x12,\n" +
+	"\x04rows\x18\x05 \x03(\v2\x18.task.v1.ImportRowResultR\x04rows\"\x9c\x02\n" +
+	"\tTaskEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12*\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x16.task.v1.TaskEventTypeR\x04type\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x05 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x06 \x01(\tR\bnewValue\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\x03R\aactorId\x120\n" +
+	"\x06source\x18\b \x01(\x0e2\x18.task.v1.TaskEventSourceR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"A\n" +
+	"\x13TaskHistoryResponse\x12*\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.task.v1.TaskEventR\x06events\"=\n" +
+	"\x0fProjectResponse\x12*\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.task.v1.ProjectR\aproject\"@\n" +
+	"\x10ProjectsResponse\x12,\n" +
+	"\bprojects\x18\x01 \x03(\v2\x10.task.v1.ProjectR\bprojects\"1\n" +
 	"\fTaskResponse\x12!\n" +
 	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"4\n" +
 	"\rTasksResponse\x12#\n" +
@@ -497,13 +7847,117 @@ const file_task_task_proto_rawDesc = "" +
 	"\x13TASK_STATUS_CREATED\x10\x00\x12\x17\n" +
 	"\x13TASK_STATUS_AT_WORK\x10\x01\x12\x19\n" +
 	"\x15TASK_STATUS_COMPLETED\x10\x02\x12\x17\n" +
-	"\x13TASK_STATUS_EXPIRED\x10\x032\x82\x03\n" +
+	"\x13TASK_STATUS_EXPIRED\x10\x03*\x89\x01\n" +
+	"\fTaskPriority\x12\x16\n" +
+	"\x12TASK_PRIORITY_NONE\x10\x00\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x02\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x18\n" +
+	"\x14TASK_PRIORITY_URGENT\x10\x04*u\n" +
+	"\vProjectRole\x12\x1c\n" +
+	"\x18PROJECT_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PROJECT_ROLE_OWNER\x10\x01\x12\x17\n" +
+	"\x13PROJECT_ROLE_EDITOR\x10\x02\x12\x17\n" +
+	"\x13PROJECT_ROLE_VIEWER\x10\x03*\xa0\x01\n" +
+	"\rTaskEventType\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_CREATED\x10\x00\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_UPDATED\x10\x01\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_DELETED\x10\x02\x12\x1c\n" +
+	"\x18TASK_EVENT_TYPE_RESTORED\x10\x03\x12\x1a\n" +
+	"\x16TASK_EVENT_TYPE_PURGED\x10\x04*l\n" +
+	"\x0fTaskEventSource\x12\x1c\n" +
+	"\x18TASK_EVENT_SOURCE_SYSTEM\x10\x00\x12\x1a\n" +
+	"\x16TASK_EVENT_SOURCE_USER\x10\x01\x12\x1f\n" +
+	"\x1bTASK_EVENT_SOURCE_SCHEDULER\x10\x02*\xbd\x01\n" +
+	"\vBatchAction\x12\x1c\n" +
+	"\x18BATCH_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17BATCH_ACTION_SET_STATUS\x10\x01\x12\x1d\n" +
+	"\x19BATCH_ACTION_SET_DUE_DATE\x10\x02\x12 \n" +
+	"\x1cBATCH_ACTION_MOVE_TO_PROJECT\x10\x03\x12\x19\n" +
+	"\x15BATCH_ACTION_SET_TAGS\x10\x04\x12\x17\n" +
+	"\x13BATCH_ACTION_DELETE\x10\x05*F\n" +
+	"\tBatchMode\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x00\x12\x1a\n" +
+	"\x16BATCH_MODE_BEST_EFFORT\x10\x01*\x9d\x01\n" +
+	"\rTaskSortOrder\x12 \n" +
+	"\x1cTASK_SORT_ORDER_DUE_DATE_ASC\x10\x00\x12!\n" +
+	"\x1dTASK_SORT_ORDER_DUE_DATE_DESC\x10\x01\x12\"\n" +
+	"\x1eTASK_SORT_ORDER_CREATED_AT_ASC\x10\x02\x12#\n" +
+	"\x1fTASK_SORT_ORDER_CREATED_AT_DESC\x10\x03*e\n" +
+	"\x0eTransferFormat\x12\x1f\n" +
+	"\x1bTRANSFER_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TRANSFER_FORMAT_CSV\x10\x01\x12\x19\n" +
+	"\x15TRANSFER_FORMAT_JSONL\x10\x02*\x93\x01\n" +
+	"\x0fImportRowStatus\x12!\n" +
+	"\x1dIMPORT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bIMPORT_ROW_STATUS_DUPLICATE\x10\x02\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_INVALID\x10\x032\x8e'\n" +
 	"\vTaskService\x12Q\n" +
-	"\aGetTask\x12\x17.task.v1.GetTaskRequest\x1a\x15.task.v1.TaskResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/tasks/{id}\x12Z\n" +
+	"\aGetTask\x12\x17.task.v1.GetTaskRequest\x1a\x15.task.v1.TaskResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/tasks/{id}\x12U\n" +
+	"\tListTasks\x12\x19.task.v1.ListTasksRequest\x1a\x1a.task.v1.ListTasksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/tasks\x12b\n" +
+	"\vSearchTasks\x12\x1b.task.v1.SearchTasksRequest\x1a\x1c.task.v1.SearchTasksResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tasks:search\x12Z\n" +
 	"\rGetTodayTasks\x12\x18.task.v1.GetTasksRequest\x1a\x16.task.v1.TasksResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/tasks/today\x12U\n" +
 	"\n" +
 	"CreateTask\x12\x1a.task.v1.CreateTaskRequest\x1a\x15.task.v1.TaskResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/tasks\x12m\n" +
-	"\x10UpdateTaskStatus\x12 .task.v1.UpdateTaskStatusRequest\x1a\x15.task.v1.TaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/tasks/{id}/statusB%Z#task-tracker/gen/public/task;taskpbb\x06proto3"
+	"\x10UpdateTaskStatus\x12 .task.v1.UpdateTaskStatusRequest\x1a\x15.task.v1.TaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/tasks/{id}/status\x12Z\n" +
+	"\n" +
+	"UpdateTask\x12\x1a.task.v1.UpdateTaskRequest\x1a\x15.task.v1.TaskResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/tasks/{id}\x12X\n" +
+	"\n" +
+	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/tasks/{id}\x12s\n" +
+	"\x10BatchUpdateTasks\x12 .task.v1.BatchUpdateTasksRequest\x1a!.task.v1.BatchUpdateTasksResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/tasks/batch\x12j\n" +
+	"\n" +
+	"AddSubtask\x12\x1a.task.v1.AddSubtaskRequest\x1a\x15.task.v1.TaskResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/tasks/{parent_id}/subtasks\x12{\n" +
+	"\x0fReorderSubtasks\x12\x1f.task.v1.ReorderSubtasksRequest\x1a\x16.task.v1.TasksResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/tasks/{parent_id}/subtasks/order\x12g\n" +
+	"\rToggleSubtask\x12\x1d.task.v1.ToggleSubtaskRequest\x1a\x15.task.v1.TaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tasks/{id}/toggle\x12[\n" +
+	"\bMoveTask\x12\x18.task.v1.MoveTaskRequest\x1a\x15.task.v1.TaskResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/tasks/{id}/move\x12a\n" +
+	"\n" +
+	"AssignTask\x12\x1a.task.v1.AssignTaskRequest\x1a\x15.task.v1.TaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tasks/{id}/assign\x12n\n" +
+	"\x0eGetTaskHistory\x12\x1e.task.v1.GetTaskHistoryRequest\x1a\x1c.task.v1.TaskHistoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/tasks/{id}/history\x12r\n" +
+	"\x10ListTaskBlockers\x12 .task.v1.ListTaskBlockersRequest\x1a\x16.task.v1.TasksResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tasks/{task_id}/blockers\x12p\n" +
+	"\x0eAddTaskBlocker\x12\x1e.task.v1.AddTaskBlockerRequest\x1a\x15.task.v1.TaskResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/tasks/{task_id}/blockers\x12\x80\x01\n" +
+	"\x11RemoveTaskBlocker\x12!.task.v1.RemoveTaskBlockerRequest\x1a\x15.task.v1.TaskResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/tasks/{task_id}/blockers/{blocker_id}\x12p\n" +
+	"\n" +
+	"StartTimer\x12\x1a.task.v1.StartTimerRequest\x1a\x1a.task.v1.TimeEntryResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tasks/{task_id}/timer/start\x12m\n" +
+	"\tStopTimer\x12\x19.task.v1.StopTimerRequest\x1a\x1a.task.v1.TimeEntryResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/tasks/{task_id}/timer/stop\x12a\n" +
+	"\x0fGetRunningTimer\x12\x1f.task.v1.GetRunningTimerRequest\x1a\x1a.task.v1.TimeEntryResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/timer\x12z\n" +
+	"\x0fListTimeEntries\x12\x1f.task.v1.ListTimeEntriesRequest\x1a\x1c.task.v1.TimeEntriesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/tasks/{task_id}/time-entries\x12u\n" +
+	"\fAddTimeEntry\x12\x1c.task.v1.AddTimeEntryRequest\x1a\x1a.task.v1.TimeEntryResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/tasks/{task_id}/time-entries\x12y\n" +
+	"\x0fDeleteTimeEntry\x12\x1f.task.v1.DeleteTimeEntryRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/v1/tasks/{task_id}/time-entries/{id}\x12d\n" +
+	"\rGetTimeReport\x12\x1d.task.v1.GetTimeReportRequest\x1a\x1b.task.v1.TimeReportResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/time-report\x12O\n" +
+	"\bGetBoard\x12\x18.task.v1.GetBoardRequest\x1a\x16.task.v1.BoardResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/board\x12s\n" +
+	"\x0fMoveTaskOnBoard\x12\x1f.task.v1.MoveTaskOnBoardRequest\x1a\x15.task.v1.TaskResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tasks/{id}/board-position\x12q\n" +
+	"\x12CreateTaskTemplate\x12\".task.v1.CreateTaskTemplateRequest\x1a\x1d.task.v1.TaskTemplateResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/templates\x12r\n" +
+	"\x10SaveTaskTemplate\x12 .task.v1.SaveTaskTemplateRequest\x1a\x1d.task.v1.TaskTemplateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/templates/save\x12m\n" +
+	"\x11ListTaskTemplates\x12!.task.v1.ListTaskTemplatesRequest\x1a\x1e.task.v1.TaskTemplatesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/templates\x12m\n" +
+	"\x0fGetTaskTemplate\x12\x1f.task.v1.GetTaskTemplateRequest\x1a\x1d.task.v1.TaskTemplateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/templates/{id}\x12l\n" +
+	"\x12DeleteTaskTemplate\x12\".task.v1.DeleteTaskTemplateRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/templates/{id}\x12\x85\x01\n" +
+	"\x17InstantiateTaskTemplate\x12'.task.v1.InstantiateTaskTemplateRequest\x1a\x16.task.v1.TasksResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/templates/{id}/instantiate\x12y\n" +
+	"\x10ListTaskComments\x12 .task.v1.ListTaskCommentsRequest\x1a\x1d.task.v1.TaskCommentsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tasks/{task_id}/comments\x12w\n" +
+	"\x0eAddTaskComment\x12\x1e.task.v1.AddTaskCommentRequest\x1a\x1c.task.v1.TaskCommentResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/tasks/{task_id}/comments\x12\x82\x01\n" +
+	"\x11UpdateTaskComment\x12!.task.v1.UpdateTaskCommentRequest\x1a\x1c.task.v1.TaskCommentResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/v1/tasks/{task_id}/comments/{id}\x12y\n" +
+	"\x11DeleteTaskComment\x12!.task.v1.DeleteTaskCommentRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#*!/v1/tasks/{task_id}/comments/{id}\x12S\n" +
+	"\x10UploadAttachment\x12 .task.v1.UploadAttachmentRequest\x1a\x1b.task.v1.AttachmentResponse(\x01\x12_\n" +
+	"\x12DownloadAttachment\x12\".task.v1.DownloadAttachmentRequest\x1a#.task.v1.DownloadAttachmentResponse0\x01\x12y\n" +
+	"\x0fListAttachments\x12\x1f.task.v1.ListAttachmentsRequest\x1a\x1c.task.v1.AttachmentsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/tasks/{task_id}/attachments\x12z\n" +
+	"\x10DeleteAttachment\x12 .task.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/v1/tasks/{task_id}/attachments/{id}\x12J\n" +
+	"\vExportTasks\x12\x1b.task.v1.ExportTasksRequest\x1a\x1c.task.v1.ExportTasksResponse0\x01\x12J\n" +
+	"\vImportTasks\x12\x1b.task.v1.ImportTasksRequest\x1a\x1c.task.v1.ImportTasksResponse(\x01\x12V\n" +
+	"\x0fGetCalendarFeed\x12\x1f.task.v1.GetCalendarFeedRequest\x1a .task.v1.GetCalendarFeedResponse0\x01\x12Q\n" +
+	"\tListTrash\x12\x19.task.v1.ListTrashRequest\x1a\x16.task.v1.TasksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trash\x12d\n" +
+	"\vRestoreTask\x12\x1b.task.v1.RestoreTaskRequest\x1a\x15.task.v1.TaskResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/trash/{id}/restore\x12V\n" +
+	"\tPurgeTask\x12\x19.task.v1.PurgeTaskRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/trash/{id}2\xa7\b\n" +
+	"\x0eProjectService\x12a\n" +
+	"\rCreateProject\x12\x1d.task.v1.CreateProjectRequest\x1a\x18.task.v1.ProjectResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/projects\x12]\n" +
+	"\n" +
+	"GetProject\x12\x1a.task.v1.GetProjectRequest\x1a\x18.task.v1.ProjectResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/projects/{id}\x12]\n" +
+	"\fListProjects\x12\x1c.task.v1.ListProjectsRequest\x1a\x19.task.v1.ProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12f\n" +
+	"\rUpdateProject\x12\x1d.task.v1.UpdateProjectRequest\x1a\x18.task.v1.ProjectResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/projects/{id}\x12a\n" +
+	"\rDeleteProject\x12\x1d.task.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/projects/{id}\x12\x84\x01\n" +
+	"\x12ListProjectMembers\x12\".task.v1.ListProjectMembersRequest\x1a\x1f.task.v1.ProjectMembersResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/members\x12\x82\x01\n" +
+	"\x10AddProjectMember\x12 .task.v1.AddProjectMemberRequest\x1a\x1e.task.v1.ProjectMemberResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/projects/{project_id}/members\x12\x92\x01\n" +
+	"\x13UpdateProjectMember\x12#.task.v1.UpdateProjectMemberRequest\x1a\x1e.task.v1.ProjectMemberResponse\"6\x82\xd3\xe4\x93\x020:\x01*2+/v1/projects/{project_id}/members/{user_id}\x12\x87\x01\n" +
+	"\x13RemoveProjectMember\x12#.task.v1.RemoveProjectMemberRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/v1/projects/{project_id}/members/{user_id}B%Z#task-tracker/gen/public/task;taskpbb\x06proto3"
 
 var (
 	file_task_task_proto_rawDescOnce sync.Once
@@ -517,36 +7971,308 @@ func file_task_task_proto_rawDescGZIP() []byte {
 	return file_task_task_proto_rawDescData
 }
 
-var file_task_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_task_task_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_task_task_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_task_task_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_task_task_proto_goTypes = []any{
-	(TaskStatus)(0),                 // 0: task.v1.TaskStatus
-	(*Task)(nil),                    // 1: task.v1.Task
-	(*GetTaskRequest)(nil),          // 2: task.v1.GetTaskRequest
-	(*GetTasksRequest)(nil),         // 3: task.v1.GetTasksRequest
-	(*CreateTaskRequest)(nil),       // 4: task.v1.CreateTaskRequest
-	(*UpdateTaskStatusRequest)(nil), // 5: task.v1.UpdateTaskStatusRequest
-	(*TaskResponse)(nil),            // 6: task.v1.TaskResponse
-	(*TasksResponse)(nil),           // 7: task.v1.TasksResponse
+	(TaskStatus)(0),                        // 0: task.v1.TaskStatus
+	(TaskPriority)(0),                      // 1: task.v1.TaskPriority
+	(ProjectRole)(0),                       // 2: task.v1.ProjectRole
+	(TaskEventType)(0),                     // 3: task.v1.TaskEventType
+	(TaskEventSource)(0),                   // 4: task.v1.TaskEventSource
+	(BatchAction)(0),                       // 5: task.v1.BatchAction
+	(BatchMode)(0),                         // 6: task.v1.BatchMode
+	(TaskSortOrder)(0),                     // 7: task.v1.TaskSortOrder
+	(TransferFormat)(0),                    // 8: task.v1.TransferFormat
+	(ImportRowStatus)(0),                   // 9: task.v1.ImportRowStatus
+	(*Task)(nil),                           // 10: task.v1.Task
+	(*GetTaskRequest)(nil),                 // 11: task.v1.GetTaskRequest
+	(*GetTasksRequest)(nil),                // 12: task.v1.GetTasksRequest
+	(*CreateTaskRequest)(nil),              // 13: task.v1.CreateTaskRequest
+	(*UpdateTaskStatusRequest)(nil),        // 14: task.v1.UpdateTaskStatusRequest
+	(*UpdateTaskRequest)(nil),              // 15: task.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),              // 16: task.v1.DeleteTaskRequest
+	(*ListTasksRequest)(nil),               // 17: task.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),              // 18: task.v1.ListTasksResponse
+	(*SearchTasksRequest)(nil),             // 19: task.v1.SearchTasksRequest
+	(*TaskSearchHit)(nil),                  // 20: task.v1.TaskSearchHit
+	(*SearchTasksResponse)(nil),            // 21: task.v1.SearchTasksResponse
+	(*BatchTaskFilter)(nil),                // 22: task.v1.BatchTaskFilter
+	(*BatchUpdateTasksRequest)(nil),        // 23: task.v1.BatchUpdateTasksRequest
+	(*BatchItemResult)(nil),                // 24: task.v1.BatchItemResult
+	(*BatchUpdateTasksResponse)(nil),       // 25: task.v1.BatchUpdateTasksResponse
+	(*AddSubtaskRequest)(nil),              // 26: task.v1.AddSubtaskRequest
+	(*ReorderSubtasksRequest)(nil),         // 27: task.v1.ReorderSubtasksRequest
+	(*ToggleSubtaskRequest)(nil),           // 28: task.v1.ToggleSubtaskRequest
+	(*MoveTaskRequest)(nil),                // 29: task.v1.MoveTaskRequest
+	(*Project)(nil),                        // 30: task.v1.Project
+	(*ProjectCounts)(nil),                  // 31: task.v1.ProjectCounts
+	(*CreateProjectRequest)(nil),           // 32: task.v1.CreateProjectRequest
+	(*GetProjectRequest)(nil),              // 33: task.v1.GetProjectRequest
+	(*ListProjectsRequest)(nil),            // 34: task.v1.ListProjectsRequest
+	(*UpdateProjectRequest)(nil),           // 35: task.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),           // 36: task.v1.DeleteProjectRequest
+	(*AssignTaskRequest)(nil),              // 37: task.v1.AssignTaskRequest
+	(*ProjectMember)(nil),                  // 38: task.v1.ProjectMember
+	(*ListProjectMembersRequest)(nil),      // 39: task.v1.ListProjectMembersRequest
+	(*AddProjectMemberRequest)(nil),        // 40: task.v1.AddProjectMemberRequest
+	(*UpdateProjectMemberRequest)(nil),     // 41: task.v1.UpdateProjectMemberRequest
+	(*RemoveProjectMemberRequest)(nil),     // 42: task.v1.RemoveProjectMemberRequest
+	(*ProjectMemberResponse)(nil),          // 43: task.v1.ProjectMemberResponse
+	(*ProjectMembersResponse)(nil),         // 44: task.v1.ProjectMembersResponse
+	(*GetTaskHistoryRequest)(nil),          // 45: task.v1.GetTaskHistoryRequest
+	(*ListTrashRequest)(nil),               // 46: task.v1.ListTrashRequest
+	(*RestoreTaskRequest)(nil),             // 47: task.v1.RestoreTaskRequest
+	(*PurgeTaskRequest)(nil),               // 48: task.v1.PurgeTaskRequest
+	(*ListTaskBlockersRequest)(nil),        // 49: task.v1.ListTaskBlockersRequest
+	(*AddTaskBlockerRequest)(nil),          // 50: task.v1.AddTaskBlockerRequest
+	(*RemoveTaskBlockerRequest)(nil),       // 51: task.v1.RemoveTaskBlockerRequest
+	(*TimeEntry)(nil),                      // 52: task.v1.TimeEntry
+	(*DailyTime)(nil),                      // 53: task.v1.DailyTime
+	(*StartTimerRequest)(nil),              // 54: task.v1.StartTimerRequest
+	(*StopTimerRequest)(nil),               // 55: task.v1.StopTimerRequest
+	(*GetRunningTimerRequest)(nil),         // 56: task.v1.GetRunningTimerRequest
+	(*AddTimeEntryRequest)(nil),            // 57: task.v1.AddTimeEntryRequest
+	(*DeleteTimeEntryRequest)(nil),         // 58: task.v1.DeleteTimeEntryRequest
+	(*ListTimeEntriesRequest)(nil),         // 59: task.v1.ListTimeEntriesRequest
+	(*TimeEntryResponse)(nil),              // 60: task.v1.TimeEntryResponse
+	(*TimeEntriesResponse)(nil),            // 61: task.v1.TimeEntriesResponse
+	(*GetTimeReportRequest)(nil),           // 62: task.v1.GetTimeReportRequest
+	(*ProjectDailyTime)(nil),               // 63: task.v1.ProjectDailyTime
+	(*ProjectTime)(nil),                    // 64: task.v1.ProjectTime
+	(*TimeReportResponse)(nil),             // 65: task.v1.TimeReportResponse
+	(*GetBoardRequest)(nil),                // 66: task.v1.GetBoardRequest
+	(*BoardColumn)(nil),                    // 67: task.v1.BoardColumn
+	(*BoardResponse)(nil),                  // 68: task.v1.BoardResponse
+	(*MoveTaskOnBoardRequest)(nil),         // 69: task.v1.MoveTaskOnBoardRequest
+	(*TemplateItem)(nil),                   // 70: task.v1.TemplateItem
+	(*TaskTemplate)(nil),                   // 71: task.v1.TaskTemplate
+	(*CreateTaskTemplateRequest)(nil),      // 72: task.v1.CreateTaskTemplateRequest
+	(*SaveTaskTemplateRequest)(nil),        // 73: task.v1.SaveTaskTemplateRequest
+	(*ListTaskTemplatesRequest)(nil),       // 74: task.v1.ListTaskTemplatesRequest
+	(*GetTaskTemplateRequest)(nil),         // 75: task.v1.GetTaskTemplateRequest
+	(*DeleteTaskTemplateRequest)(nil),      // 76: task.v1.DeleteTaskTemplateRequest
+	(*InstantiateTaskTemplateRequest)(nil), // 77: task.v1.InstantiateTaskTemplateRequest
+	(*TaskTemplateResponse)(nil),           // 78: task.v1.TaskTemplateResponse
+	(*TaskTemplatesResponse)(nil),          // 79: task.v1.TaskTemplatesResponse
+	(*TaskComment)(nil),                    // 80: task.v1.TaskComment
+	(*ListTaskCommentsRequest)(nil),        // 81: task.v1.ListTaskCommentsRequest
+	(*AddTaskCommentRequest)(nil),          // 82: task.v1.AddTaskCommentRequest
+	(*UpdateTaskCommentRequest)(nil),       // 83: task.v1.UpdateTaskCommentRequest
+	(*DeleteTaskCommentRequest)(nil),       // 84: task.v1.DeleteTaskCommentRequest
+	(*TaskCommentResponse)(nil),            // 85: task.v1.TaskCommentResponse
+	(*TaskCommentsResponse)(nil),           // 86: task.v1.TaskCommentsResponse
+	(*Attachment)(nil),                     // 87: task.v1.Attachment
+	(*AttachmentUploadInfo)(nil),           // 88: task.v1.AttachmentUploadInfo
+	(*UploadAttachmentRequest)(nil),        // 89: task.v1.UploadAttachmentRequest
+	(*DownloadAttachmentRequest)(nil),      // 90: task.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),     // 91: task.v1.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),         // 92: task.v1.ListAttachmentsRequest
+	(*DeleteAttachmentRequest)(nil),        // 93: task.v1.DeleteAttachmentRequest
+	(*AttachmentResponse)(nil),             // 94: task.v1.AttachmentResponse
+	(*AttachmentsResponse)(nil),            // 95: task.v1.AttachmentsResponse
+	(*ExportTasksRequest)(nil),             // 96: task.v1.ExportTasksRequest
+	(*ExportInfo)(nil),                     // 97: task.v1.ExportInfo
+	(*ExportTasksResponse)(nil),            // 98: task.v1.ExportTasksResponse
+	(*GetCalendarFeedRequest)(nil),         // 99: task.v1.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),        // 100: task.v1.GetCalendarFeedResponse
+	(*ImportTasksInfo)(nil),                // 101: task.v1.ImportTasksInfo
+	(*ImportTasksRequest)(nil),             // 102: task.v1.ImportTasksRequest
+	(*ImportRowResult)(nil),                // 103: task.v1.ImportRowResult
+	(*ImportTasksResponse)(nil),            // 104: task.v1.ImportTasksResponse
+	(*TaskEvent)(nil),                      // 105: task.v1.TaskEvent
+	(*TaskHistoryResponse)(nil),            // 106: task.v1.TaskHistoryResponse
+	(*ProjectResponse)(nil),                // 107: task.v1.ProjectResponse
+	(*ProjectsResponse)(nil),               // 108: task.v1.ProjectsResponse
+	(*TaskResponse)(nil),                   // 109: task.v1.TaskResponse
+	(*TasksResponse)(nil),                  // 110: task.v1.TasksResponse
+	(*fieldmaskpb.FieldMask)(nil),          // 111: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 112: google.protobuf.Empty
 }
 var file_task_task_proto_depIdxs = []int32{
-	0, // 0: task.v1.Task.status:type_name -> task.v1.TaskStatus
-	0, // 1: task.v1.UpdateTaskStatusRequest.status:type_name -> task.v1.TaskStatus
-	1, // 2: task.v1.TaskResponse.task:type_name -> task.v1.Task
-	1, // 3: task.v1.TasksResponse.tasks:type_name -> task.v1.Task
-	2, // 4: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
-	3, // 5: task.v1.TaskService.GetTodayTasks:input_type -> task.v1.GetTasksRequest
-	4, // 6: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
-	5, // 7: task.v1.TaskService.UpdateTaskStatus:input_type -> task.v1.UpdateTaskStatusRequest
-	6, // 8: task.v1.TaskService.GetTask:output_type -> task.v1.TaskResponse
-	7, // 9: task.v1.TaskService.GetTodayTasks:output_type -> task.v1.TasksResponse
-	6, // 10: task.v1.TaskService.CreateTask:output_type -> task.v1.TaskResponse
-	6, // 11: task.v1.TaskService.UpdateTaskStatus:output_type -> task.v1.TaskResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,   // 0: task.v1.Task.status:type_name -> task.v1.TaskStatus
+	1,   // 1: task.v1.Task.priority:type_name -> task.v1.TaskPriority
+	10,  // 2: task.v1.Task.subtasks:type_name -> task.v1.Task
+	1,   // 3: task.v1.CreateTaskRequest.priority:type_name -> task.v1.TaskPriority
+	0,   // 4: task.v1.UpdateTaskStatusRequest.status:type_name -> task.v1.TaskStatus
+	111, // 5: task.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 6: task.v1.UpdateTaskRequest.priority:type_name -> task.v1.TaskPriority
+	0,   // 7: task.v1.ListTasksRequest.statuses:type_name -> task.v1.TaskStatus
+	7,   // 8: task.v1.ListTasksRequest.sort:type_name -> task.v1.TaskSortOrder
+	1,   // 9: task.v1.ListTasksRequest.priorities:type_name -> task.v1.TaskPriority
+	10,  // 10: task.v1.ListTasksResponse.tasks:type_name -> task.v1.Task
+	0,   // 11: task.v1.SearchTasksRequest.statuses:type_name -> task.v1.TaskStatus
+	1,   // 12: task.v1.SearchTasksRequest.priorities:type_name -> task.v1.TaskPriority
+	10,  // 13: task.v1.TaskSearchHit.task:type_name -> task.v1.Task
+	20,  // 14: task.v1.SearchTasksResponse.hits:type_name -> task.v1.TaskSearchHit
+	0,   // 15: task.v1.BatchTaskFilter.statuses:type_name -> task.v1.TaskStatus
+	1,   // 16: task.v1.BatchTaskFilter.priorities:type_name -> task.v1.TaskPriority
+	22,  // 17: task.v1.BatchUpdateTasksRequest.filter:type_name -> task.v1.BatchTaskFilter
+	6,   // 18: task.v1.BatchUpdateTasksRequest.mode:type_name -> task.v1.BatchMode
+	5,   // 19: task.v1.BatchUpdateTasksRequest.action:type_name -> task.v1.BatchAction
+	0,   // 20: task.v1.BatchUpdateTasksRequest.status:type_name -> task.v1.TaskStatus
+	10,  // 21: task.v1.BatchItemResult.task:type_name -> task.v1.Task
+	24,  // 22: task.v1.BatchUpdateTasksResponse.results:type_name -> task.v1.BatchItemResult
+	1,   // 23: task.v1.AddSubtaskRequest.priority:type_name -> task.v1.TaskPriority
+	31,  // 24: task.v1.Project.counts:type_name -> task.v1.ProjectCounts
+	2,   // 25: task.v1.Project.role:type_name -> task.v1.ProjectRole
+	111, // 26: task.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 27: task.v1.ProjectMember.role:type_name -> task.v1.ProjectRole
+	2,   // 28: task.v1.AddProjectMemberRequest.role:type_name -> task.v1.ProjectRole
+	2,   // 29: task.v1.UpdateProjectMemberRequest.role:type_name -> task.v1.ProjectRole
+	38,  // 30: task.v1.ProjectMemberResponse.member:type_name -> task.v1.ProjectMember
+	38,  // 31: task.v1.ProjectMembersResponse.members:type_name -> task.v1.ProjectMember
+	52,  // 32: task.v1.TimeEntryResponse.entry:type_name -> task.v1.TimeEntry
+	52,  // 33: task.v1.TimeEntriesResponse.entries:type_name -> task.v1.TimeEntry
+	53,  // 34: task.v1.TimeEntriesResponse.days:type_name -> task.v1.DailyTime
+	63,  // 35: task.v1.TimeReportResponse.rows:type_name -> task.v1.ProjectDailyTime
+	64,  // 36: task.v1.TimeReportResponse.projects:type_name -> task.v1.ProjectTime
+	0,   // 37: task.v1.BoardColumn.status:type_name -> task.v1.TaskStatus
+	10,  // 38: task.v1.BoardColumn.tasks:type_name -> task.v1.Task
+	67,  // 39: task.v1.BoardResponse.columns:type_name -> task.v1.BoardColumn
+	0,   // 40: task.v1.MoveTaskOnBoardRequest.status:type_name -> task.v1.TaskStatus
+	1,   // 41: task.v1.TemplateItem.priority:type_name -> task.v1.TaskPriority
+	70,  // 42: task.v1.TemplateItem.subtasks:type_name -> task.v1.TemplateItem
+	70,  // 43: task.v1.TaskTemplate.items:type_name -> task.v1.TemplateItem
+	70,  // 44: task.v1.CreateTaskTemplateRequest.items:type_name -> task.v1.TemplateItem
+	71,  // 45: task.v1.TaskTemplateResponse.template:type_name -> task.v1.TaskTemplate
+	71,  // 46: task.v1.TaskTemplatesResponse.templates:type_name -> task.v1.TaskTemplate
+	80,  // 47: task.v1.TaskCommentResponse.comment:type_name -> task.v1.TaskComment
+	80,  // 48: task.v1.TaskCommentsResponse.comments:type_name -> task.v1.TaskComment
+	88,  // 49: task.v1.UploadAttachmentRequest.info:type_name -> task.v1.AttachmentUploadInfo
+	87,  // 50: task.v1.DownloadAttachmentResponse.attachment:type_name -> task.v1.Attachment
+	87,  // 51: task.v1.AttachmentResponse.attachment:type_name -> task.v1.Attachment
+	87,  // 52: task.v1.AttachmentsResponse.attachments:type_name -> task.v1.Attachment
+	8,   // 53: task.v1.ExportTasksRequest.format:type_name -> task.v1.TransferFormat
+	97,  // 54: task.v1.ExportTasksResponse.info:type_name -> task.v1.ExportInfo
+	97,  // 55: task.v1.GetCalendarFeedResponse.info:type_name -> task.v1.ExportInfo
+	8,   // 56: task.v1.ImportTasksInfo.format:type_name -> task.v1.TransferFormat
+	101, // 57: task.v1.ImportTasksRequest.info:type_name -> task.v1.ImportTasksInfo
+	9,   // 58: task.v1.ImportRowResult.status:type_name -> task.v1.ImportRowStatus
+	103, // 59: task.v1.ImportTasksResponse.rows:type_name -> task.v1.ImportRowResult
+	3,   // 60: task.v1.TaskEvent.type:type_name -> task.v1.TaskEventType
+	4,   // 61: task.v1.TaskEvent.source:type_name -> task.v1.TaskEventSource
+	105, // 62: task.v1.TaskHistoryResponse.events:type_name -> task.v1.TaskEvent
+	30,  // 63: task.v1.ProjectResponse.project:type_name -> task.v1.Project
+	30,  // 64: task.v1.ProjectsResponse.projects:type_name -> task.v1.Project
+	10,  // 65: task.v1.TaskResponse.task:type_name -> task.v1.Task
+	10,  // 66: task.v1.TasksResponse.tasks:type_name -> task.v1.Task
+	11,  // 67: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
+	17,  // 68: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
+	19,  // 69: task.v1.TaskService.SearchTasks:input_type -> task.v1.SearchTasksRequest
+	12,  // 70: task.v1.TaskService.GetTodayTasks:input_type -> task.v1.GetTasksRequest
+	13,  // 71: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
+	14,  // 72: task.v1.TaskService.UpdateTaskStatus:input_type -> task.v1.UpdateTaskStatusRequest
+	15,  // 73: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
+	16,  // 74: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
+	23,  // 75: task.v1.TaskService.BatchUpdateTasks:input_type -> task.v1.BatchUpdateTasksRequest
+	26,  // 76: task.v1.TaskService.AddSubtask:input_type -> task.v1.AddSubtaskRequest
+	27,  // 77: task.v1.TaskService.ReorderSubtasks:input_type -> task.v1.ReorderSubtasksRequest
+	28,  // 78: task.v1.TaskService.ToggleSubtask:input_type -> task.v1.ToggleSubtaskRequest
+	29,  // 79: task.v1.TaskService.MoveTask:input_type -> task.v1.MoveTaskRequest
+	37,  // 80: task.v1.TaskService.AssignTask:input_type -> task.v1.AssignTaskRequest
+	45,  // 81: task.v1.TaskService.GetTaskHistory:input_type -> task.v1.GetTaskHistoryRequest
+	49,  // 82: task.v1.TaskService.ListTaskBlockers:input_type -> task.v1.ListTaskBlockersRequest
+	50,  // 83: task.v1.TaskService.AddTaskBlocker:input_type -> task.v1.AddTaskBlockerRequest
+	51,  // 84: task.v1.TaskService.RemoveTaskBlocker:input_type -> task.v1.RemoveTaskBlockerRequest
+	54,  // 85: task.v1.TaskService.StartTimer:input_type -> task.v1.StartTimerRequest
+	55,  // 86: task.v1.TaskService.StopTimer:input_type -> task.v1.StopTimerRequest
+	56,  // 87: task.v1.TaskService.GetRunningTimer:input_type -> task.v1.GetRunningTimerRequest
+	59,  // 88: task.v1.TaskService.ListTimeEntries:input_type -> task.v1.ListTimeEntriesRequest
+	57,  // 89: task.v1.TaskService.AddTimeEntry:input_type -> task.v1.AddTimeEntryRequest
+	58,  // 90: task.v1.TaskService.DeleteTimeEntry:input_type -> task.v1.DeleteTimeEntryRequest
+	62,  // 91: task.v1.TaskService.GetTimeReport:input_type -> task.v1.GetTimeReportRequest
+	66,  // 92: task.v1.TaskService.GetBoard:input_type -> task.v1.GetBoardRequest
+	69,  // 93: task.v1.TaskService.MoveTaskOnBoard:input_type -> task.v1.MoveTaskOnBoardRequest
+	72,  // 94: task.v1.TaskService.CreateTaskTemplate:input_type -> task.v1.CreateTaskTemplateRequest
+	73,  // 95: task.v1.TaskService.SaveTaskTemplate:input_type -> task.v1.SaveTaskTemplateRequest
+	74,  // 96: task.v1.TaskService.ListTaskTemplates:input_type -> task.v1.ListTaskTemplatesRequest
+	75,  // 97: task.v1.TaskService.GetTaskTemplate:input_type -> task.v1.GetTaskTemplateRequest
+	76,  // 98: task.v1.TaskService.DeleteTaskTemplate:input_type -> task.v1.DeleteTaskTemplateRequest
+	77,  // 99: task.v1.TaskService.InstantiateTaskTemplate:input_type -> task.v1.InstantiateTaskTemplateRequest
+	81,  // 100: task.v1.TaskService.ListTaskComments:input_type -> task.v1.ListTaskCommentsRequest
+	82,  // 101: task.v1.TaskService.AddTaskComment:input_type -> task.v1.AddTaskCommentRequest
+	83,  // 102: task.v1.TaskService.UpdateTaskComment:input_type -> task.v1.UpdateTaskCommentRequest
+	84,  // 103: task.v1.TaskService.DeleteTaskComment:input_type -> task.v1.DeleteTaskCommentRequest
+	89,  // 104: task.v1.TaskService.UploadAttachment:input_type -> task.v1.UploadAttachmentRequest
+	90,  // 105: task.v1.TaskService.DownloadAttachment:input_type -> task.v1.DownloadAttachmentRequest
+	92,  // 106: task.v1.TaskService.ListAttachments:input_type -> task.v1.ListAttachmentsRequest
+	93,  // 107: task.v1.TaskService.DeleteAttachment:input_type -> task.v1.DeleteAttachmentRequest
+	96,  // 108: task.v1.TaskService.ExportTasks:input_type -> task.v1.ExportTasksRequest
+	102, // 109: task.v1.TaskService.ImportTasks:input_type -> task.v1.ImportTasksRequest
+	99,  // 110: task.v1.TaskService.GetCalendarFeed:input_type -> task.v1.GetCalendarFeedRequest
+	46,  // 111: task.v1.TaskService.ListTrash:input_type -> task.v1.ListTrashRequest
+	47,  // 112: task.v1.TaskService.RestoreTask:input_type -> task.v1.RestoreTaskRequest
+	48,  // 113: task.v1.TaskService.PurgeTask:input_type -> task.v1.PurgeTaskRequest
+	32,  // 114: task.v1.ProjectService.CreateProject:input_type -> task.v1.CreateProjectRequest
+	33,  // 115: task.v1.ProjectService.GetProject:input_type -> task.v1.GetProjectRequest
+	34,  // 116: task.v1.ProjectService.ListProjects:input_type -> task.v1.ListProjectsRequest
+	35,  // 117: task.v1.ProjectService.UpdateProject:input_type -> task.v1.UpdateProjectRequest
+	36,  // 118: task.v1.ProjectService.DeleteProject:input_type -> task.v1.DeleteProjectRequest
+	39,  // 119: task.v1.ProjectService.ListProjectMembers:input_type -> task.v1.ListProjectMembersRequest
+	40,  // 120: task.v1.ProjectService.AddProjectMember:input_type -> task.v1.AddProjectMemberRequest
+	41,  // 121: task.v1.ProjectService.UpdateProjectMember:input_type -> task.v1.UpdateProjectMemberRequest
+	42,  // 122: task.v1.ProjectService.RemoveProjectMember:input_type -> task.v1.RemoveProjectMemberRequest
+	109, // 123: task.v1.TaskService.GetTask:output_type -> task.v1.TaskResponse
+	18,  // 124: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
+	21,  // 125: task.v1.TaskService.SearchTasks:output_type -> task.v1.SearchTasksResponse
+	110, // 126: task.v1.TaskService.GetTodayTasks:output_type -> task.v1.TasksResponse
+	109, // 127: task.v1.TaskService.CreateTask:output_type -> task.v1.TaskResponse
+	109, // 128: task.v1.TaskService.UpdateTaskStatus:output_type -> task.v1.TaskResponse
+	109, // 129: task.v1.TaskService.UpdateTask:output_type -> task.v1.TaskResponse
+	112, // 130: task.v1.TaskService.DeleteTask:output_type -> google.protobuf.Empty
+	25,  // 131: task.v1.TaskService.BatchUpdateTasks:output_type -> task.v1.BatchUpdateTasksResponse
+	109, // 132: task.v1.TaskService.AddSubtask:output_type -> task.v1.TaskResponse
+	110, // 133: task.v1.TaskService.ReorderSubtasks:output_type -> task.v1.TasksResponse
+	109, // 134: task.v1.TaskService.ToggleSubtask:output_type -> task.v1.TaskResponse
+	109, // 135: task.v1.TaskService.MoveTask:output_type -> task.v1.TaskResponse
+	109, // 136: task.v1.TaskService.AssignTask:output_type -> task.v1.TaskResponse
+	106, // 137: task.v1.TaskService.GetTaskHistory:output_type -> task.v1.TaskHistoryResponse
+	110, // 138: task.v1.TaskService.ListTaskBlockers:output_type -> task.v1.TasksResponse
+	109, // 139: task.v1.TaskService.AddTaskBlocker:output_type -> task.v1.TaskResponse
+	109, // 140: task.v1.TaskService.RemoveTaskBlocker:output_type -> task.v1.TaskResponse
+	60,  // 141: task.v1.TaskService.StartTimer:output_type -> task.v1.TimeEntryResponse
+	60,  // 142: task.v1.TaskService.StopTimer:output_type -> task.v1.TimeEntryResponse
+	60,  // 143: task.v1.TaskService.GetRunningTimer:output_type -> task.v1.TimeEntryResponse
+	61,  // 144: task.v1.TaskService.ListTimeEntries:output_type -> task.v1.TimeEntriesResponse
+	60,  // 145: task.v1.TaskService.AddTimeEntry:output_type -> task.v1.TimeEntryResponse
+	112, // 146: task.v1.TaskService.DeleteTimeEntry:output_type -> google.protobuf.Empty
+	65,  // 147: task.v1.TaskService.GetTimeReport:output_type -> task.v1.TimeReportResponse
+	68,  // 148: task.v1.TaskService.GetBoard:output_type -> task.v1.BoardResponse
+	109, // 149: task.v1.TaskService.MoveTaskOnBoard:output_type -> task.v1.TaskResponse
+	78,  // 150: task.v1.TaskService.CreateTaskTemplate:output_type -> task.v1.TaskTemplateResponse
+	78,  // 151: task.v1.TaskService.SaveTaskTemplate:output_type -> task.v1.TaskTemplateResponse
+	79,  // 152: task.v1.TaskService.ListTaskTemplates:output_type -> task.v1.TaskTemplatesResponse
+	78,  // 153: task.v1.TaskService.GetTaskTemplate:output_type -> task.v1.TaskTemplateResponse
+	112, // 154: task.v1.TaskService.DeleteTaskTemplate:output_type -> google.protobuf.Empty
+	110, // 155: task.v1.TaskService.InstantiateTaskTemplate:output_type -> task.v1.TasksResponse
+	86,  // 156: task.v1.TaskService.ListTaskComments:output_type -> task.v1.TaskCommentsResponse
+	85,  // 157: task.v1.TaskService.AddTaskComment:output_type -> task.v1.TaskCommentResponse
+	85,  // 158: task.v1.TaskService.UpdateTaskComment:output_type -> task.v1.TaskCommentResponse
+	112, // 159: task.v1.TaskService.DeleteTaskComment:output_type -> google.protobuf.Empty
+	94,  // 160: task.v1.TaskService.UploadAttachment:output_type -> task.v1.AttachmentResponse
+	91,  // 161: task.v1.TaskService.DownloadAttachment:output_type -> task.v1.DownloadAttachmentResponse
+	95,  // 162: task.v1.TaskService.ListAttachments:output_type -> task.v1.AttachmentsResponse
+	112, // 163: task.v1.TaskService.DeleteAttachment:output_type -> google.protobuf.Empty
+	98,  // 164: task.v1.TaskService.ExportTasks:output_type -> task.v1.ExportTasksResponse
+	104, // 165: task.v1.TaskService.ImportTasks:output_type -> task.v1.ImportTasksResponse
+	100, // 166: task.v1.TaskService.GetCalendarFeed:output_type -> task.v1.GetCalendarFeedResponse
+	110, // 167: task.v1.TaskService.ListTrash:output_type -> task.v1.TasksResponse
+	109, // 168: task.v1.TaskService.RestoreTask:output_type -> task.v1.TaskResponse
+	112, // 169: task.v1.TaskService.PurgeTask:output_type -> google.protobuf.Empty
+	107, // 170: task.v1.ProjectService.CreateProject:output_type -> task.v1.ProjectResponse
+	107, // 171: task.v1.ProjectService.GetProject:output_type -> task.v1.ProjectResponse
+	108, // 172: task.v1.ProjectService.ListProjects:output_type -> task.v1.ProjectsResponse
+	107, // 173: task.v1.ProjectService.UpdateProject:output_type -> task.v1.ProjectResponse
+	112, // 174: task.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	44,  // 175: task.v1.ProjectService.ListProjectMembers:output_type -> task.v1.ProjectMembersResponse
+	43,  // 176: task.v1.ProjectService.AddProjectMember:output_type -> task.v1.ProjectMemberResponse
+	43,  // 177: task.v1.ProjectService.UpdateProjectMember:output_type -> task.v1.ProjectMemberResponse
+	112, // 178: task.v1.ProjectService.RemoveProjectMember:output_type -> google.protobuf.Empty
+	123, // [123:179] is the sub-list for method output_type
+	67,  // [67:123] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_task_task_proto_init() }
@@ -554,15 +8280,35 @@ func file_task_task_proto_init() {
 	if File_task_task_proto != nil {
 		return
 	}
+	file_task_task_proto_msgTypes[79].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_task_task_proto_msgTypes[81].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_task_task_proto_msgTypes[88].OneofWrappers = []any{
+		(*ExportTasksResponse_Info)(nil),
+		(*ExportTasksResponse_Chunk)(nil),
+	}
+	file_task_task_proto_msgTypes[90].OneofWrappers = []any{
+		(*GetCalendarFeedResponse_Info)(nil),
+		(*GetCalendarFeedResponse_Chunk)(nil),
+	}
+	file_task_task_proto_msgTypes[92].OneofWrappers = []any{
+		(*ImportTasksRequest_Info)(nil),
+		(*ImportTasksRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_task_proto_rawDesc), len(file_task_task_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      10,
+			NumMessages:   101,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_task_task_proto_goTypes,
 		DependencyIndexes: file_task_task_proto_depIdxs,
@@ -9,16 +9,23 @@ type User struct {
 	ID           int64
 	Email        string
 	PasswordHash string
+	TimeZone     string
 }
 
 var (
 	ErrNotFound           = errors.New("not found")
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidTimeZone    = errors.New("invalid time zone")
 )
 
 type UserRepository interface {
 	Create(ctx context.Context, user User) (User, error)
 	GetByEmail(ctx context.Context, email string) (User, error)
 	GetByIDs(ctx context.Context, ids []int64) ([]User, error)
+	UpdateTimeZoneByID(ctx context.Context, id int64, timeZone string) (User, error)
+	// SetCalendarFeedTokenHashByID replaces the calendar feed token of a
+	// user; an empty hash revokes it.
+	SetCalendarFeedTokenHashByID(ctx context.Context, id int64, hash string) error
+	GetByCalendarFeedTokenHash(ctx context.Context, hash string) (User, error)
 }
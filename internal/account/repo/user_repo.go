@@ -22,8 +22,8 @@ func NewUserRepository(conn *sql.DB) UserRepository {
 
 func (r *UserRepository) Create(ctx context.Context, user domain.User) (domain.User, error) {
 	query, args, err := squirrel.Insert("users").
-		Columns("email", "password").
-		Values(user.Email, user.PasswordHash).
+		Columns("email", "password", "time_zone").
+		Values(user.Email, user.PasswordHash, user.TimeZone).
 		Suffix("RETURNING id").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -42,7 +42,7 @@ func (r *UserRepository) Create(ctx context.Context, user domain.User) (domain.U
 }
 
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
-	query, args, err := squirrel.Select("id", "email", "password").
+	query, args, err := squirrel.Select("id", "email", "password", "time_zone").
 		From("users").
 		Where(squirrel.Eq{"email": email}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -53,7 +53,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.U
 	logger.Log.Infof("sql: %s", query)
 
 	user := domain.User{}
-	err = r.conn.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.Email, &user.PasswordHash)
+	err = r.conn.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.TimeZone)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.User{}, domain.ErrNotFound
@@ -67,7 +67,7 @@ func (r *UserRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Us
 	if len(ids) == 0 {
 		return []domain.User{}, nil
 	}
-	query, args, err := squirrel.Select("id", "email", "password").
+	query, args, err := squirrel.Select("id", "email", "password", "time_zone").
 		From("users").
 		Where(squirrel.Eq{"id": ids}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -85,7 +85,7 @@ func (r *UserRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Us
 	var users []domain.User
 	for rows.Next() {
 		user := domain.User{}
-		if err := rows.Scan(&user.ID, &user.Email, &user.PasswordHash); err != nil {
+		if err := rows.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.TimeZone); err != nil {
 			return nil, fmt.Errorf("select users: %w", err)
 		}
 		users = append(users, user)
@@ -95,3 +95,78 @@ func (r *UserRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Us
 	}
 	return users, nil
 }
+
+func (r *UserRepository) UpdateTimeZoneByID(ctx context.Context, id int64, timeZone string) (domain.User, error) {
+	query, args, err := squirrel.Update("users").
+		Set("time_zone", timeZone).
+		Where(squirrel.Eq{"id": id}).
+		Suffix("RETURNING id, email, password, time_zone").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.User{}, fmt.Errorf("update user: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	user := domain.User{}
+	err = r.conn.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.TimeZone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.ErrNotFound
+		}
+		return domain.User{}, fmt.Errorf("update user: %w", err)
+	}
+	return user, nil
+}
+
+func (r *UserRepository) SetCalendarFeedTokenHashByID(ctx context.Context, id int64, hash string) error {
+	var value sql.NullString
+	if hash != "" {
+		value = sql.NullString{String: hash, Valid: true}
+	}
+
+	query, args, err := squirrel.Update("users").
+		Set("calendar_feed_token_hash", value).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) GetByCalendarFeedTokenHash(ctx context.Context, hash string) (domain.User, error) {
+	query, args, err := squirrel.Select("id", "email", "password", "time_zone").
+		From("users").
+		Where(squirrel.Eq{"calendar_feed_token_hash": hash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.User{}, fmt.Errorf("select user: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	user := domain.User{}
+	err = r.conn.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.TimeZone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.ErrNotFound
+		}
+		return domain.User{}, fmt.Errorf("select user: %w", err)
+	}
+	return user, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	accountpb "task-tracker/gen/public/account"
 	"task-tracker/internal/account/domain"
@@ -37,7 +38,7 @@ func (h AuthHandler) Register(ctx context.Context, req *accountpb.RegisterReques
 		return nil, status.Error(codes.InvalidArgument, "password must be at least 8 characters")
 	}
 
-	jwt, err := h.svc.Register(ctx, req.GetEmail(), req.GetPassword())
+	jwt, err := h.svc.Register(ctx, req.GetEmail(), req.GetPassword(), req.GetTimeZone())
 	if err != nil {
 		return nil, mapAuthError(err)
 	}
@@ -57,6 +58,48 @@ func (h AuthHandler) Login(ctx context.Context, req *accountpb.LoginRequest) (*a
 	return &accountpb.AuthResponse{Jwt: jwt}, nil
 }
 
+func (h AuthHandler) SetTimeZone(ctx context.Context, req *accountpb.SetTimeZoneRequest) (*accountpb.AuthResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc set time zone: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+	if req.GetTimeZone() == "" {
+		logger.Log.Infof("grpc set time zone: empty time zone")
+		return nil, status.Error(codes.InvalidArgument, "time_zone is required")
+	}
+
+	jwt, err := h.svc.SetTimeZone(ctx, req.GetJwt(), req.GetTimeZone())
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &accountpb.AuthResponse{Jwt: jwt}, nil
+}
+
+func (h AuthHandler) RotateCalendarFeed(ctx context.Context, req *accountpb.RotateCalendarFeedRequest) (*accountpb.CalendarFeedResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc rotate calendar feed: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	token, err := h.svc.RotateCalendarFeed(ctx, req.GetJwt())
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &accountpb.CalendarFeedResponse{Token: token}, nil
+}
+
+func (h AuthHandler) RevokeCalendarFeed(ctx context.Context, req *accountpb.RevokeCalendarFeedRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc revoke calendar feed: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.RevokeCalendarFeed(ctx, req.GetJwt()); err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func validateEmailPassword(email string, password string) error {
 	if !emailPattern.MatchString(email) {
 		return errors.New("invalid email format")
@@ -71,8 +114,10 @@ func mapAuthError(err error) error {
 	switch {
 	case errors.Is(err, domain.ErrUserAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
-	case errors.Is(err, domain.ErrInvalidCredentials):
+	case errors.Is(err, domain.ErrInvalidCredentials), errors.Is(err, usecase.ErrInvalidToken):
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrInvalidTimeZone):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, domain.ErrNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	default:
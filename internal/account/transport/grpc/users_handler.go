@@ -36,11 +36,37 @@ func (h UsersHandler) GetUsersByIDs(ctx context.Context, req *accountpb.GetUsers
 
 	resp := &accountpb.UsersResponse{Users: make([]*accountpb.User, 0, len(users))}
 	for _, user := range users {
-		resp.Users = append(resp.Users, &accountpb.User{Id: user.ID, Email: user.Email})
+		resp.Users = append(resp.Users, &accountpb.User{Id: user.ID, Email: user.Email, TimeZone: user.TimeZone})
 	}
 	return resp, nil
 }
 
+func (h UsersHandler) GetUserByEmail(ctx context.Context, req *accountpb.GetUserByEmailRequest) (*accountpb.UserResponse, error) {
+	if req.GetEmail() == "" {
+		logger.Log.Infof("grpc get user by email: empty email")
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	user, err := h.svc.GetUserByEmail(ctx, req.GetEmail())
+	if err != nil {
+		return nil, mapUsersError(err)
+	}
+	return &accountpb.UserResponse{User: &accountpb.User{Id: user.ID, Email: user.Email, TimeZone: user.TimeZone}}, nil
+}
+
+func (h UsersHandler) GetUserByCalendarFeedToken(ctx context.Context, req *accountpb.GetUserByCalendarFeedTokenRequest) (*accountpb.UserResponse, error) {
+	if req.GetToken() == "" {
+		logger.Log.Infof("grpc get user by calendar feed: empty token")
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	user, err := h.svc.GetUserByCalendarFeedToken(ctx, req.GetToken())
+	if err != nil {
+		return nil, mapUsersError(err)
+	}
+	return &accountpb.UserResponse{User: &accountpb.User{Id: user.ID, Email: user.Email, TimeZone: user.TimeZone}}, nil
+}
+
 func mapUsersError(err error) error {
 	switch {
 	case errors.Is(err, domain.ErrNotFound):
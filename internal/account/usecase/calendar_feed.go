@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"task-tracker/internal/account/domain"
+	"task-tracker/pkg/logger"
+)
+
+const calendarFeedTokenBytes = 32
+
+// RotateCalendarFeed issues a new secret calendar feed token for the caller,
+// replacing any previous one. Only a hash is stored, so the token is shown
+// once; a lost token is replaced by rotating again.
+func (s *AuthService) RotateCalendarFeed(ctx context.Context, token string) (string, error) {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("auth rotate calendar feed: invalid token err=%v", err)
+		return "", ErrInvalidToken
+	}
+
+	var secret [calendarFeedTokenBytes]byte
+	if _, err := rand.Read(secret[:]); err != nil {
+		logger.Log.Infof("auth rotate calendar feed: random error id=%d err=%v", userID, err)
+		return "", err
+	}
+	feedToken := base64.RawURLEncoding.EncodeToString(secret[:])
+
+	if err := s.repo.SetCalendarFeedTokenHashByID(ctx, userID, hashFeedToken(feedToken)); err != nil {
+		logger.Log.Infof("auth rotate calendar feed: repo error id=%d err=%v", userID, err)
+		return "", err
+	}
+	logger.Log.Infof("auth rotate calendar feed: success id=%d", userID)
+	return feedToken, nil
+}
+
+// RevokeCalendarFeed disables the caller's calendar feed until it is rotated
+// again.
+func (s *AuthService) RevokeCalendarFeed(ctx context.Context, token string) error {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("auth revoke calendar feed: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+
+	if err := s.repo.SetCalendarFeedTokenHashByID(ctx, userID, ""); err != nil {
+		logger.Log.Infof("auth revoke calendar feed: repo error id=%d err=%v", userID, err)
+		return err
+	}
+	logger.Log.Infof("auth revoke calendar feed: success id=%d", userID)
+	return nil
+}
+
+func (s *AuthService) GetUserByCalendarFeedToken(ctx context.Context, feedToken string) (domain.User, error) {
+	if feedToken == "" {
+		logger.Log.Infof("auth get user by calendar feed: empty token")
+		return domain.User{}, domain.ErrNotFound
+	}
+
+	user, err := s.repo.GetByCalendarFeedTokenHash(ctx, hashFeedToken(feedToken))
+	if err != nil {
+		logger.Log.Infof("auth get user by calendar feed: repo error err=%v", err)
+		return domain.User{}, err
+	}
+	logger.Log.Infof("auth get user by calendar feed: success id=%d", user.ID)
+	return user, nil
+}
+
+func hashFeedToken(feedToken string) string {
+	sum := sha256.Sum256([]byte(feedToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,18 +3,23 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"task-tracker/internal/account/domain"
 	"task-tracker/pkg/logger"
+	"task-tracker/pkg/timezone"
 )
 
+var ErrInvalidToken = errors.New("invalid token")
+
 type PasswordHasher interface {
 	Hash(password string) (string, error)
 	Compare(hash string, password string) bool
 }
 
 type TokenManager interface {
-	NewToken(userID int64, email string) (string, error)
+	NewToken(userID int64, email, timeZone string) (string, error)
+	ParseUserID(token string) (int64, error)
 }
 
 type AuthService struct {
@@ -32,7 +37,15 @@ func NewAuthService(repo domain.UserRepository, hasher PasswordHasher, tokens To
 	return &AuthService{repo: repo, hasher: hasher, tokens: tokens, publisher: publisher}
 }
 
-func (s *AuthService) Register(ctx context.Context, email string, password string) (string, error) {
+func (s *AuthService) Register(ctx context.Context, email string, password string, timeZone string) (string, error) {
+	if timeZone == "" {
+		timeZone = timezone.Default
+	}
+	if _, err := timezone.Load(timeZone); err != nil {
+		logger.Log.Infof("auth register: invalid time zone email=%s time_zone=%q", email, timeZone)
+		return "", domain.ErrInvalidTimeZone
+	}
+
 	_, err := s.repo.GetByEmail(ctx, email)
 	switch {
 	case err == nil:
@@ -51,7 +64,7 @@ func (s *AuthService) Register(ctx context.Context, email string, password strin
 		return "", err
 	}
 
-	user, err := s.repo.Create(ctx, domain.User{Email: email, PasswordHash: hash})
+	user, err := s.repo.Create(ctx, domain.User{Email: email, PasswordHash: hash, TimeZone: timeZone})
 	if err != nil {
 		logger.Log.Infof("auth register: create user error email=%s err=%v", email, err)
 		return "", err
@@ -65,7 +78,7 @@ func (s *AuthService) Register(ctx context.Context, email string, password strin
 		}
 	}
 
-	token, err := s.tokens.NewToken(user.ID, user.Email)
+	token, err := s.tokens.NewToken(user.ID, user.Email, user.TimeZone)
 	if err != nil {
 		logger.Log.Infof("auth register: new token error id=%d email=%s err=%v", user.ID, user.Email, err)
 		return "", err
@@ -90,7 +103,7 @@ func (s *AuthService) Login(ctx context.Context, email string, password string)
 		return "", domain.ErrInvalidCredentials
 	}
 
-	token, err := s.tokens.NewToken(user.ID, user.Email)
+	token, err := s.tokens.NewToken(user.ID, user.Email, user.TimeZone)
 	if err != nil {
 		logger.Log.Infof("auth login: new token error id=%d email=%s err=%v", user.ID, user.Email, err)
 		return "", err
@@ -99,6 +112,33 @@ func (s *AuthService) Login(ctx context.Context, email string, password string)
 	return token, nil
 }
 
+func (s *AuthService) SetTimeZone(ctx context.Context, token string, timeZone string) (string, error) {
+	if _, err := timezone.Load(timeZone); err != nil {
+		logger.Log.Infof("auth set time zone: invalid time zone time_zone=%q", timeZone)
+		return "", domain.ErrInvalidTimeZone
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("auth set time zone: invalid token err=%v", err)
+		return "", ErrInvalidToken
+	}
+
+	user, err := s.repo.UpdateTimeZoneByID(ctx, userID, timeZone)
+	if err != nil {
+		logger.Log.Infof("auth set time zone: repo error id=%d err=%v", userID, err)
+		return "", err
+	}
+
+	newToken, err := s.tokens.NewToken(user.ID, user.Email, user.TimeZone)
+	if err != nil {
+		logger.Log.Infof("auth set time zone: new token error id=%d err=%v", user.ID, err)
+		return "", err
+	}
+	logger.Log.Infof("auth set time zone: success id=%d time_zone=%s", user.ID, user.TimeZone)
+	return newToken, nil
+}
+
 func (s *AuthService) GetUsersByIDs(ctx context.Context, ids []int64) ([]domain.User, error) {
 	if len(ids) == 0 {
 		logger.Log.Infof("auth get users: empty ids")
@@ -112,3 +152,13 @@ func (s *AuthService) GetUsersByIDs(ctx context.Context, ids []int64) ([]domain.
 	logger.Log.Infof("auth get users: result count=%d", len(users))
 	return users, nil
 }
+
+func (s *AuthService) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
+	user, err := s.repo.GetByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		logger.Log.Infof("auth get user by email: repo error email=%s err=%v", email, err)
+		return domain.User{}, err
+	}
+	logger.Log.Infof("auth get user by email: success id=%d", user.ID)
+	return user, nil
+}
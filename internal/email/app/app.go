@@ -71,12 +71,26 @@ func Run() {
 	}
 	defer dailyReader.Close()
 
+	reminderReader, err := pkgkafka.NewReader(cfg.KafkaBroker, cfg.ReminderTopic, cfg.GroupID+"-reminder")
+	if err != nil {
+		logger.Log.Fatalf("init reminder reader: %v", err)
+	}
+	defer reminderReader.Close()
+
+	dependencyReader, err := pkgkafka.NewReader(cfg.KafkaBroker, cfg.DependencyTopic, cfg.GroupID+"-dependency")
+	if err != nil {
+		logger.Log.Fatalf("init dependency reader: %v", err)
+	}
+	defer dependencyReader.Close()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 4)
 	go consumer.ConsumeRegister(ctx, &readerAdapter{reader: registerReader}, errCh)
 	go consumer.ConsumeDaily(ctx, &readerAdapter{reader: dailyReader}, accountClient, errCh)
+	go consumer.ConsumeReminders(ctx, &readerAdapter{reader: reminderReader}, accountClient, errCh)
+	go consumer.ConsumeBlockerCompleted(ctx, &readerAdapter{reader: dependencyReader}, accountClient, errCh)
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
@@ -10,6 +10,8 @@ type Config struct {
 	KafkaBroker       string
 	RegisterTopic     string
 	DailySummaryTopic string
+	ReminderTopic     string
+	DependencyTopic   string
 	GroupID           string
 	AccountGRPCAddr   string
 	RedisAddr         string
@@ -48,6 +50,8 @@ func Load() (Config, error) {
 		KafkaBroker:       env.GetEnvOrDefault("KAFKA_BROKER", "localhost:9092"),
 		RegisterTopic:     env.GetEnvOrDefault("KAFKA_REGISTER_TOPIC", "register"),
 		DailySummaryTopic: env.GetEnvOrDefault("KAFKA_DAILY_TOPIC", "task-daily-summary"),
+		ReminderTopic:     env.GetEnvOrDefault("KAFKA_REMINDER_TOPIC", "task-reminders"),
+		DependencyTopic:   env.GetEnvOrDefault("KAFKA_DEPENDENCY_TOPIC", "task-blocker-completed"),
 		GroupID:           env.GetEnvOrDefault("KAFKA_GROUP_ID", "email-sender"),
 		AccountGRPCAddr:   env.GetEnvOrDefault("ACCOUNT_GRPC_ADDR", "localhost:50051"),
 		RedisAddr:         env.GetEnvOrDefault("REDIS_ADDR", "localhost:6379"),
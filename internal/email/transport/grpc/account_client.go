@@ -15,14 +15,14 @@ func NewAccountClientAdapter(client accountpb.UsersServiceClient) AccountClientA
 	return AccountClientAdapter{client: client}
 }
 
-func (a AccountClientAdapter) GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]string, error) {
+func (a AccountClientAdapter) GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]kafka.User, error) {
 	resp, err := a.client.GetUsersByIDs(ctx, &accountpb.GetUsersByIDsRequest{Ids: ids})
 	if err != nil {
 		return nil, err
 	}
-	result := make(map[int64]string, len(resp.GetUsers()))
+	result := make(map[int64]kafka.User, len(resp.GetUsers()))
 	for _, user := range resp.GetUsers() {
-		result[user.GetId()] = user.GetEmail()
+		result[user.GetId()] = kafka.User{Email: user.GetEmail(), TimeZone: user.GetTimeZone()}
 	}
 	return result, nil
 }
@@ -17,8 +17,13 @@ type MessageReader interface {
 	CommitMessages(ctx context.Context, msg Message) error
 }
 
+type User struct {
+	Email    string
+	TimeZone string
+}
+
 type UsersClient interface {
-	GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]string, error)
+	GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]User, error)
 }
 
 type Consumer struct {
@@ -89,7 +94,7 @@ func (c *Consumer) ConsumeDaily(ctx context.Context, reader MessageReader, users
 			continue
 		}
 
-		emailByID, err := users.GetUsersByIDs(ctx, ids)
+		usersByID, err := users.GetUsersByIDs(ctx, ids)
 		if err != nil {
 			logger.Log.Infof("get users by ids: %v", err)
 			_ = reader.CommitMessages(ctx, msg)
@@ -97,15 +102,109 @@ func (c *Consumer) ConsumeDaily(ctx context.Context, reader MessageReader, users
 		}
 
 		for _, user := range payload.Users {
-			email := emailByID[user.UserID]
-			if email == "" {
+			recipient := usersByID[user.UserID]
+			if recipient.Email == "" {
 				logger.Log.Infof("kafka daily: missing email user_id=%d", user.UserID)
 				continue
 			}
-			if err := c.service.SendDailySummary(ctx, email, user.UserID, user.Completed, user.NotCompleted, payload.Date); err != nil {
+			date := usecase.SummaryDate(payload, recipient.TimeZone)
+			if err := c.service.SendDailySummary(ctx, recipient.Email, user, date); err != nil {
 				logger.Log.Infof("send daily summary: %v", err)
 			}
 		}
 		_ = reader.CommitMessages(ctx, msg)
 	}
 }
+
+func (c *Consumer) ConsumeReminders(ctx context.Context, reader MessageReader, users UsersClient, errCh chan<- error) {
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			errCh <- err
+			return
+		}
+
+		var payload usecase.ReminderMessage
+		if err := json.Unmarshal(msg.Value, &payload); err != nil {
+			logger.Log.Infof("kafka reminder: invalid payload err=%v", err)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+		if payload.UserID <= 0 {
+			logger.Log.Infof("kafka reminder: invalid user id=%d", payload.UserID)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+
+		usersByID, err := users.GetUsersByIDs(ctx, []int64{payload.UserID})
+		if err != nil {
+			logger.Log.Infof("get users by ids: %v", err)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+
+		recipient := usersByID[payload.UserID]
+		if recipient.Email == "" {
+			logger.Log.Infof("kafka reminder: missing email user_id=%d", payload.UserID)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+		if err := c.service.SendReminder(ctx, recipient.Email, recipient.TimeZone, payload); err != nil {
+			logger.Log.Infof("send reminder: %v", err)
+		}
+		_ = reader.CommitMessages(ctx, msg)
+	}
+}
+
+// ConsumeBlockerCompleted notifies the owner and the assignee of a task that
+// one of its blockers has been completed.
+func (c *Consumer) ConsumeBlockerCompleted(ctx context.Context, reader MessageReader, users UsersClient, errCh chan<- error) {
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			errCh <- err
+			return
+		}
+
+		var payload usecase.BlockerCompletedMessage
+		if err := json.Unmarshal(msg.Value, &payload); err != nil {
+			logger.Log.Infof("kafka blocker completed: invalid payload err=%v", err)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+		if payload.UserID <= 0 {
+			logger.Log.Infof("kafka blocker completed: invalid user id=%d", payload.UserID)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+
+		ids := []int64{payload.UserID}
+		if payload.AssigneeID > 0 && payload.AssigneeID != payload.UserID {
+			ids = append(ids, payload.AssigneeID)
+		}
+		usersByID, err := users.GetUsersByIDs(ctx, ids)
+		if err != nil {
+			logger.Log.Infof("get users by ids: %v", err)
+			_ = reader.CommitMessages(ctx, msg)
+			continue
+		}
+
+		for _, id := range ids {
+			recipient := usersByID[id]
+			if recipient.Email == "" {
+				logger.Log.Infof("kafka blocker completed: missing email user_id=%d", id)
+				continue
+			}
+			if err := c.service.SendBlockerCompleted(ctx, recipient.Email, payload); err != nil {
+				logger.Log.Infof("send blocker completed: %v", err)
+			}
+		}
+		_ = reader.CommitMessages(ctx, msg)
+	}
+}
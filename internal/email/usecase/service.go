@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"task-tracker/pkg/logger"
+	"task-tracker/pkg/timezone"
 	"time"
 )
 
@@ -32,14 +33,41 @@ type RegisterMessage struct {
 }
 
 type DailySummaryUser struct {
-	UserID       int64 `json:"user_id"`
-	Completed    int   `json:"completed"`
-	NotCompleted int   `json:"not_completed"`
+	UserID       int64                  `json:"user_id"`
+	Completed    int                    `json:"completed"`
+	NotCompleted int                    `json:"not_completed"`
+	ByPriority   []DailySummaryPriority `json:"by_priority"`
+}
+
+type DailySummaryPriority struct {
+	Priority     string `json:"priority"`
+	Completed    int    `json:"completed"`
+	NotCompleted int    `json:"not_completed"`
 }
 
 type DailySummaryMessage struct {
-	Date  string             `json:"date"`
-	Users []DailySummaryUser `json:"users"`
+	Date      string             `json:"date"`
+	WindowEnd int64              `json:"window_end"`
+	Users     []DailySummaryUser `json:"users"`
+}
+
+type ReminderMessage struct {
+	TaskID        int64  `json:"task_id"`
+	UserID        int64  `json:"user_id"`
+	Description   string `json:"description"`
+	DueDate       int64  `json:"due_date"`
+	OffsetMinutes int64  `json:"offset_minutes"`
+	RemindAt      int64  `json:"remind_at"`
+}
+
+type BlockerCompletedMessage struct {
+	TaskID             int64  `json:"task_id"`
+	UserID             int64  `json:"user_id"`
+	AssigneeID         int64  `json:"assignee_id"`
+	Description        string `json:"description"`
+	BlockerID          int64  `json:"blocker_id"`
+	BlockerDescription string `json:"blocker_description"`
+	Remaining          int    `json:"remaining"`
 }
 
 func (s *Service) SendWelcome(ctx context.Context, msg RegisterMessage) error {
@@ -64,7 +92,16 @@ func (s *Service) SendWelcome(ctx context.Context, msg RegisterMessage) error {
 	return nil
 }
 
-func (s *Service) SendDailySummary(ctx context.Context, email string, userID int64, completed, notCompleted int, date string) error {
+func SummaryDate(msg DailySummaryMessage, timeZone string) string {
+	if msg.Date != "" || msg.WindowEnd <= 0 {
+		return msg.Date
+	}
+	loc := timezone.LoadOrDefault(timeZone, time.UTC)
+	return time.Unix(msg.WindowEnd, 0).In(loc).Format("02.01.2006")
+}
+
+func (s *Service) SendDailySummary(ctx context.Context, email string, user DailySummaryUser, date string) error {
+	userID := user.UserID
 	if email == "" {
 		logger.Log.Infof("email send daily: empty email user_id=%d", userID)
 		return errors.New("empty email")
@@ -85,7 +122,10 @@ func (s *Service) SendDailySummary(ctx context.Context, email string, userID int
 		dateLine = "сегодня"
 	}
 	subject := "Ежедневный отчет по задачам"
-	body := fmt.Sprintf("Ваш отчет за %s:\nВыполнено: %d\nНе выполнено: %d", dateLine, completed, notCompleted)
+	body := fmt.Sprintf("Ваш отчет за %s:\nВыполнено: %d\nНе выполнено: %d", dateLine, user.Completed, user.NotCompleted)
+	if lines := priorityLines(user.ByPriority); lines != "" {
+		body += "\n\nПо приоритетам:\n" + lines
+	}
 	if err := s.mailer.Send(email, subject, body); err != nil {
 		logger.Log.Infof("email send daily: send error user_id=%d email=%s err=%v", userID, email, err)
 		return err
@@ -94,6 +134,104 @@ func (s *Service) SendDailySummary(ctx context.Context, email string, userID int
 	return nil
 }
 
+func (s *Service) SendReminder(ctx context.Context, email string, timeZone string, msg ReminderMessage) error {
+	if email == "" {
+		logger.Log.Infof("email send reminder: empty email task_id=%d", msg.TaskID)
+		return errors.New("empty email")
+	}
+	if msg.TaskID <= 0 || msg.DueDate <= 0 {
+		logger.Log.Infof("email send reminder: invalid task task_id=%d", msg.TaskID)
+		return errors.New("invalid task")
+	}
+	if ok, err := s.allow(ctx, keyReminder(msg)); err != nil || !ok {
+		if err != nil {
+			logger.Log.Infof("email send reminder: dedupe error task_id=%d err=%v", msg.TaskID, err)
+		}
+		return err
+	}
+
+	loc := timezone.LoadOrDefault(timeZone, time.UTC)
+	dueDate := time.Unix(msg.DueDate, 0).In(loc).Format("02.01.2006 15:04 MST")
+	subject := "Напоминание о задаче"
+	body := fmt.Sprintf("Срок выполнения задачи наступает через %s (%s):\n%s", reminderOffsetText(msg.OffsetMinutes), dueDate, msg.Description)
+	if err := s.mailer.Send(email, subject, body); err != nil {
+		logger.Log.Infof("email send reminder: send error task_id=%d email=%s err=%v", msg.TaskID, email, err)
+		return err
+	}
+	logger.Log.Infof("email send reminder: success task_id=%d email=%s", msg.TaskID, email)
+	return nil
+}
+
+func (s *Service) SendBlockerCompleted(ctx context.Context, email string, msg BlockerCompletedMessage) error {
+	if email == "" {
+		logger.Log.Infof("email send blocker completed: empty email task_id=%d", msg.TaskID)
+		return errors.New("empty email")
+	}
+	if msg.TaskID <= 0 || msg.BlockerID <= 0 {
+		logger.Log.Infof("email send blocker completed: invalid task task_id=%d blocker_id=%d", msg.TaskID, msg.BlockerID)
+		return errors.New("invalid task")
+	}
+	if ok, err := s.allow(ctx, keyBlockerCompleted(msg, email)); err != nil || !ok {
+		if err != nil {
+			logger.Log.Infof("email send blocker completed: dedupe error task_id=%d err=%v", msg.TaskID, err)
+		}
+		return err
+	}
+
+	subject := "Блокирующая задача выполнена"
+	body := fmt.Sprintf("Задача «%s» выполнена.\n", msg.BlockerDescription)
+	if msg.Remaining > 0 {
+		body += fmt.Sprintf("Задача «%s» всё ещё ожидает выполнения других задач: %d.", msg.Description, msg.Remaining)
+	} else {
+		body += fmt.Sprintf("Задача «%s» больше не заблокирована, можно приступать к работе.", msg.Description)
+	}
+	if err := s.mailer.Send(email, subject, body); err != nil {
+		logger.Log.Infof("email send blocker completed: send error task_id=%d email=%s err=%v", msg.TaskID, email, err)
+		return err
+	}
+	logger.Log.Infof("email send blocker completed: success task_id=%d blocker_id=%d email=%s", msg.TaskID, msg.BlockerID, email)
+	return nil
+}
+
+func reminderOffsetText(minutes int64) string {
+	switch {
+	case minutes > 0 && minutes%(24*60) == 0:
+		return fmt.Sprintf("%d дн.", minutes/(24*60))
+	case minutes > 0 && minutes%60 == 0:
+		return fmt.Sprintf("%d ч.", minutes/60)
+	default:
+		return fmt.Sprintf("%d мин.", minutes)
+	}
+}
+
+var priorityTitles = []struct {
+	key   string
+	title string
+}{
+	{key: "urgent", title: "Срочный"},
+	{key: "high", title: "Высокий"},
+	{key: "medium", title: "Средний"},
+	{key: "low", title: "Низкий"},
+	{key: "none", title: "Без приоритета"},
+}
+
+func priorityLines(byPriority []DailySummaryPriority) string {
+	counts := make(map[string]DailySummaryPriority, len(byPriority))
+	for _, item := range byPriority {
+		counts[item.Priority] = item
+	}
+
+	var lines []string
+	for _, priority := range priorityTitles {
+		item, ok := counts[priority.key]
+		if !ok {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: выполнено %d, не выполнено %d", priority.title, item.Completed, item.NotCompleted))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (s *Service) allow(ctx context.Context, key string) (bool, error) {
 	if s.dedupe == nil {
 		return true, nil
@@ -114,5 +252,16 @@ func keyRegister(email string) string {
 }
 
 func keyDaily(date string, userID int64) string {
-	return fmt.Sprintf("%d", userID)
+	if date == "" {
+		return fmt.Sprintf("%d", userID)
+	}
+	return fmt.Sprintf("daily:%s:%d", date, userID)
+}
+
+func keyReminder(msg ReminderMessage) string {
+	return fmt.Sprintf("reminder:%d:%d:%d", msg.TaskID, msg.DueDate, msg.OffsetMinutes)
+}
+
+func keyBlockerCompleted(msg BlockerCompletedMessage, email string) string {
+	return fmt.Sprintf("blocker:%d:%d:%s", msg.TaskID, msg.BlockerID, keyRegister(email))
 }
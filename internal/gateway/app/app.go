@@ -27,7 +27,12 @@ func Run() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(ifMatchMetadata),
+		runtime.WithMetadata(idempotencyKeyMetadata),
+		runtime.WithForwardResponseOption(forwardETag),
+		runtime.WithErrorHandler(errorHandler),
+	)
 	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	accountConn, err := grpc.NewClient(cfg.AccountGRPCAddr, dialOpts...)
@@ -56,6 +61,19 @@ func Run() {
 	if err := taskpb.RegisterTaskServiceHandler(ctx, mux, taskConn); err != nil {
 		logger.Log.Fatalf("register task handler: %v", err)
 	}
+	if err := taskpb.RegisterProjectServiceHandler(ctx, mux, taskConn); err != nil {
+		logger.Log.Fatalf("register project handler: %v", err)
+	}
+	taskClient := taskpb.NewTaskServiceClient(taskConn)
+	if err := registerAttachmentRoutes(mux, taskClient, cfg.MaxUploadSize); err != nil {
+		logger.Log.Fatalf("register attachment routes: %v", err)
+	}
+	if err := registerTransferRoutes(mux, taskClient, cfg.MaxUploadSize); err != nil {
+		logger.Log.Fatalf("register transfer routes: %v", err)
+	}
+	if err := registerCalendarRoutes(mux, taskClient); err != nil {
+		logger.Log.Fatalf("register calendar routes: %v", err)
+	}
 
 	server := &http.Server{
 		Addr:              cfg.HTTPAddr,
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/pkg/logger"
+)
+
+const (
+	uploadPattern      = "/v1/tasks/{task_id}/attachments"
+	downloadPattern    = "/v1/tasks/{task_id}/attachments/{id}/content"
+	uploadFormMemory   = 1 << 20
+	uploadChunkSize    = 64 * 1024
+	uploadFormOverhead = 1 << 20
+)
+
+// registerAttachmentRoutes exposes the streaming attachment RPCs, which the
+// generated gateway cannot map: uploads as multipart/form-data with a "file"
+// part and downloads as the raw file body. Both take their token from the
+// Authorization header; a token in the URL would end up in logs and browser
+// history.
+func registerAttachmentRoutes(mux *runtime.ServeMux, client taskpb.TaskServiceClient, maxUpload int64) error {
+	if err := mux.HandlePath(http.MethodPost, uploadPattern, uploadAttachment(mux, client, maxUpload)); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, downloadPattern, downloadAttachment(mux, client))
+}
+
+func uploadAttachment(mux *runtime.ServeMux, client taskpb.TaskServiceClient, maxUpload int64) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, uploadErrorWriter(w, err), r, err)
+		}
+
+		taskID, err := strconv.ParseInt(pathParams["task_id"], 10, 64)
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "invalid task_id"))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxUpload+uploadFormOverhead)
+		if err := r.ParseMultipartForm(uploadFormMemory); err != nil {
+			fail(formError(err))
+			return
+		}
+		defer func() {
+			if err := r.MultipartForm.RemoveAll(); err != nil {
+				logger.Log.Infof("gateway upload attachment: cleanup error err=%v", err)
+			}
+		}()
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "missing file"))
+			return
+		}
+		defer file.Close()
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, taskpb.TaskService_UploadAttachment_FullMethodName, runtime.WithHTTPPathPattern(uploadPattern))
+		if err != nil {
+			fail(err)
+			return
+		}
+		stream, err := client.UploadAttachment(ctx)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		err = stream.Send(&taskpb.UploadAttachmentRequest{Payload: &taskpb.UploadAttachmentRequest_Info{Info: &taskpb.AttachmentUploadInfo{
+			Jwt:         bearerToken(r),
+			TaskId:      taskID,
+			FileName:    header.Filename,
+			ContentType: header.Header.Get("Content-Type"),
+			Size:        header.Size,
+		}}})
+		buf := make([]byte, uploadChunkSize)
+		for err == nil {
+			var n int
+			n, err = file.Read(buf)
+			if n > 0 {
+				if sendErr := stream.Send(&taskpb.UploadAttachmentRequest{Payload: &taskpb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]}}); sendErr != nil {
+					err = sendErr
+				}
+			}
+		}
+		if !errors.Is(err, io.EOF) {
+			logger.Log.Infof("gateway upload attachment: send error err=%v", err)
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			fail(err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, resp)
+	}
+}
+
+func downloadAttachment(mux *runtime.ServeMux, client taskpb.TaskServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+		}
+
+		taskID, err := strconv.ParseInt(pathParams["task_id"], 10, 64)
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "invalid task_id"))
+			return
+		}
+		id, err := strconv.ParseInt(pathParams["id"], 10, 64)
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "invalid id"))
+			return
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, taskpb.TaskService_DownloadAttachment_FullMethodName, runtime.WithHTTPPathPattern(downloadPattern))
+		if err != nil {
+			fail(err)
+			return
+		}
+		stream, err := client.DownloadAttachment(ctx, &taskpb.DownloadAttachmentRequest{
+			Jwt:    bearerToken(r),
+			TaskId: taskID,
+			Id:     id,
+		})
+		if err != nil {
+			fail(err)
+			return
+		}
+		first, err := stream.Recv()
+		if err != nil {
+			fail(err)
+			return
+		}
+		attachment := first.GetAttachment()
+		if attachment == nil {
+			fail(status.Error(codes.Internal, "missing attachment metadata"))
+			return
+		}
+
+		w.Header().Set("Content-Type", attachment.GetContentType())
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.GetSize(), 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.GetFileName()}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				logger.Log.Infof("gateway download attachment: recv error id=%d err=%v", id, err)
+				return
+			}
+			if _, err := w.Write(msg.GetChunk()); err != nil {
+				logger.Log.Infof("gateway download attachment: write error id=%d err=%v", id, err)
+				return
+			}
+		}
+	}
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header, or ""
+// when there is none.
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// uploadErrorWriter makes an upload handler report ResourceExhausted, which
+// there means the file is over the size limit, as 413 Content Too Large
+// instead of the 429 the gateway uses by default.
+func uploadErrorWriter(w http.ResponseWriter, err error) http.ResponseWriter {
+	if status.Code(err) == codes.ResourceExhausted {
+		return statusWriter{ResponseWriter: w, code: http.StatusRequestEntityTooLarge}
+	}
+	return w
+}
+
+// formError reports a multipart form over the upload limit as
+// ResourceExhausted and any other parse failure as InvalidArgument.
+func formError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return status.Error(codes.ResourceExhausted, "request body too large")
+	}
+	return status.Error(codes.InvalidArgument, "invalid multipart form")
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	taskpb "task-tracker/gen/public/task"
+)
+
+const calendarFeedPattern = "/v1/calendar/{token}/tasks.ics"
+
+// registerCalendarRoutes exposes the streaming calendar feed RPC as a
+// download. It is authorized by the secret token in its path rather than
+// the Authorization header, so calendar apps can subscribe to it.
+func registerCalendarRoutes(mux *runtime.ServeMux, client taskpb.TaskServiceClient) error {
+	return mux.HandlePath(http.MethodGet, calendarFeedPattern, calendarFeed(mux, client))
+}
+
+func calendarFeed(mux *runtime.ServeMux, client taskpb.TaskServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, taskpb.TaskService_GetCalendarFeed_FullMethodName, runtime.WithHTTPPathPattern(calendarFeedPattern))
+		if err != nil {
+			fail(err)
+			return
+		}
+		stream, err := client.GetCalendarFeed(ctx, &taskpb.GetCalendarFeedRequest{Token: pathParams["token"]})
+		if err != nil {
+			fail(err)
+			return
+		}
+		recv := func() (exportPart, error) { return stream.Recv() }
+		writeExportFile(w, recv, fail, "gateway calendar feed")
+	}
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	taskpb "task-tracker/gen/public/task"
+)
+
+const expectedVersionKey = "x-expected-version"
+
+// versionMismatchReason marks the Aborted status the task service returns
+// for a version mismatch.
+const versionMismatchReason = "VERSION_MISMATCH"
+
+func ifMatchMetadata(_ context.Context, r *http.Request) metadata.MD {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil
+	}
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	return metadata.Pairs(expectedVersionKey, value)
+}
+
+func forwardETag(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	resp, ok := msg.(*taskpb.TaskResponse)
+	if !ok || resp.GetTask() == nil {
+		return nil
+	}
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(resp.GetTask().GetVersion(), 10)))
+	return nil
+}
+
+// errorHandler reports version conflicts as 412 Precondition Failed instead of
+// the 409 the gateway uses for codes.Aborted by default. Any other Aborted
+// status keeps the 409.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if isVersionMismatch(err) {
+		w = statusWriter{ResponseWriter: w, code: http.StatusPreconditionFailed}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+func isVersionMismatch(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == versionMismatchReason {
+			return true
+		}
+	}
+	return false
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.code)
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const idempotencyKeyHeader = "idempotency-key"
+
+func idempotencyKeyMetadata(_ context.Context, r *http.Request) metadata.MD {
+	value := strings.TrimSpace(r.Header.Get("Idempotency-Key"))
+	if value == "" {
+		return nil
+	}
+	return metadata.Pairs(idempotencyKeyHeader, value)
+}
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/pkg/logger"
+)
+
+const (
+	exportPattern = "/v1/export/tasks"
+	importPattern = "/v1/import/tasks"
+)
+
+// registerTransferRoutes exposes the streaming task export and import RPCs:
+// exports as a file download and imports as multipart/form-data with a
+// "file" part. The format is "csv" or "jsonl"; an import without one is
+// read by the file extension. Both take their token from the Authorization
+// header, like attachment transfers.
+func registerTransferRoutes(mux *runtime.ServeMux, client taskpb.TaskServiceClient, maxUpload int64) error {
+	if err := mux.HandlePath(http.MethodGet, exportPattern, exportTasks(mux, client)); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodPost, importPattern, importTasks(mux, client, maxUpload))
+}
+
+func exportTasks(mux *runtime.ServeMux, client taskpb.TaskServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+		}
+
+		query := r.URL.Query()
+		name := query.Get("format")
+		if name == "" {
+			name = "csv"
+		}
+		format, ok := parseTransferFormat(name)
+		if !ok {
+			fail(status.Error(codes.InvalidArgument, "invalid format"))
+			return
+		}
+		var projectID int64
+		if value := query.Get("project_id"); value != "" {
+			var err error
+			if projectID, err = strconv.ParseInt(value, 10, 64); err != nil {
+				fail(status.Error(codes.InvalidArgument, "invalid project_id"))
+				return
+			}
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, taskpb.TaskService_ExportTasks_FullMethodName, runtime.WithHTTPPathPattern(exportPattern))
+		if err != nil {
+			fail(err)
+			return
+		}
+		stream, err := client.ExportTasks(ctx, &taskpb.ExportTasksRequest{
+			Jwt:       bearerToken(r),
+			Format:    format,
+			ProjectId: projectID,
+		})
+		if err != nil {
+			fail(err)
+			return
+		}
+		recv := func() (exportPart, error) { return stream.Recv() }
+		writeExportFile(w, recv, fail, "gateway export tasks")
+	}
+}
+
+// exportPart is a message of the file framing shared by ExportTasks and
+// GetCalendarFeed: the file info first, then the content in chunks.
+type exportPart interface {
+	GetInfo() *taskpb.ExportInfo
+	GetChunk() []byte
+}
+
+// writeExportFile copies a file streamed in the export framing to w.
+// Errors before the file info arrives are reported through fail; later ones
+// can only cut the body short.
+func writeExportFile(w http.ResponseWriter, recv func() (exportPart, error), fail func(error), op string) {
+	first, err := recv()
+	if err != nil {
+		fail(err)
+		return
+	}
+	info := first.GetInfo()
+	if info == nil {
+		fail(status.Error(codes.Internal, "missing export info"))
+		return
+	}
+
+	w.Header().Set("Content-Type", info.GetContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": info.GetFileName()}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			logger.Log.Infof("%s: recv error err=%v", op, err)
+			return
+		}
+		if _, err := w.Write(msg.GetChunk()); err != nil {
+			logger.Log.Infof("%s: write error err=%v", op, err)
+			return
+		}
+	}
+}
+
+func importTasks(mux *runtime.ServeMux, client taskpb.TaskServiceClient, maxUpload int64) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, uploadErrorWriter(w, err), r, err)
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxUpload+uploadFormOverhead)
+		if err := r.ParseMultipartForm(uploadFormMemory); err != nil {
+			fail(formError(err))
+			return
+		}
+		defer func() {
+			if err := r.MultipartForm.RemoveAll(); err != nil {
+				logger.Log.Infof("gateway import tasks: cleanup error err=%v", err)
+			}
+		}()
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "missing file"))
+			return
+		}
+		defer file.Close()
+
+		name := r.FormValue("format")
+		if name == "" {
+			name = strings.TrimPrefix(filepath.Ext(header.Filename), ".")
+		}
+		format, ok := parseTransferFormat(name)
+		if !ok {
+			fail(status.Error(codes.InvalidArgument, "invalid format"))
+			return
+		}
+		dryRun := false
+		if value := r.FormValue("dry_run"); value != "" {
+			if dryRun, err = strconv.ParseBool(value); err != nil {
+				fail(status.Error(codes.InvalidArgument, "invalid dry_run"))
+				return
+			}
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, taskpb.TaskService_ImportTasks_FullMethodName, runtime.WithHTTPPathPattern(importPattern))
+		if err != nil {
+			fail(err)
+			return
+		}
+		// Closing the stream tells the server the file is complete, so a
+		// failed upload cancels it instead; otherwise the server would import
+		// a truncated file.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := client.ImportTasks(ctx)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		var readErr error
+		sendErr := stream.Send(&taskpb.ImportTasksRequest{Payload: &taskpb.ImportTasksRequest_Info{Info: &taskpb.ImportTasksInfo{
+			Jwt:    bearerToken(r),
+			Format: format,
+			DryRun: dryRun,
+		}}})
+		buf := make([]byte, uploadChunkSize)
+		for readErr == nil && sendErr == nil {
+			var n int
+			n, readErr = file.Read(buf)
+			if n > 0 {
+				sendErr = stream.Send(&taskpb.ImportTasksRequest{Payload: &taskpb.ImportTasksRequest_Chunk{Chunk: buf[:n]}})
+			}
+		}
+		// A send fails with io.EOF when the server has already ended the
+		// stream; CloseAndRecv then reports its status.
+		if sendErr != nil && !errors.Is(sendErr, io.EOF) {
+			cancel()
+			logger.Log.Infof("gateway import tasks: send error err=%v", sendErr)
+			fail(sendErr)
+			return
+		}
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			cancel()
+			logger.Log.Infof("gateway import tasks: read error err=%v", readErr)
+			fail(status.Error(codes.Internal, "cannot read uploaded file"))
+			return
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			fail(err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, resp)
+	}
+}
+
+func parseTransferFormat(name string) (taskpb.TransferFormat, bool) {
+	switch strings.ToLower(name) {
+	case "csv":
+		return taskpb.TransferFormat_TRANSFER_FORMAT_CSV, true
+	case "jsonl", "ndjson":
+		return taskpb.TransferFormat_TRANSFER_FORMAT_JSONL, true
+	default:
+		return taskpb.TransferFormat_TRANSFER_FORMAT_UNSPECIFIED, false
+	}
+}
@@ -11,6 +11,7 @@ type Config struct {
 	AccountGRPCAddr string
 	TaskGRPCAddr    string
 	ShutdownTimeout time.Duration
+	MaxUploadSize   int64
 }
 
 func Load() (Config, error) {
@@ -19,11 +20,17 @@ func Load() (Config, error) {
 		return Config{}, err
 	}
 
+	maxUploadSize, err := env.GetEnvAsInt("GATEWAY_MAX_UPLOAD_SIZE", 20<<20)
+	if err != nil {
+		return Config{}, err
+	}
+
 	cfg := Config{
 		HTTPAddr:        env.GetEnvOrDefault("GATEWAY_HTTP_ADDR", ":8080"),
 		AccountGRPCAddr: env.GetEnvOrDefault("ACCOUNT_GRPC_ADDR", ":50051"),
 		TaskGRPCAddr:    env.GetEnvOrDefault("TASK_GRPC_ADDR", ":50052"),
 		ShutdownTimeout: timeout,
+		MaxUploadSize:   int64(maxUploadSize),
 	}
 	return cfg, nil
 }
@@ -38,21 +38,48 @@ func Run() {
 	ticker := time.NewTicker(cfg.Interval)
 	defer ticker.Stop()
 
-	run := func() {
+	call := func(name string, fn func(ctx context.Context) error) {
 		ctx, cancel := context.WithTimeout(context.Background(), cfg.CallTimeout)
 		defer cancel()
 
-		logger.Log.Infof("scheduler: process recent expired start")
-		if _, err := client.ProcessRecentExpired(ctx, &emptypb.Empty{}); err != nil {
+		logger.Log.Infof("scheduler: %s start", name)
+		if err := fn(ctx); err != nil {
 			st := status.Convert(err)
 			if st != nil {
-				logger.Log.Infof("process recent expired: %s", st.Message())
+				logger.Log.Infof("%s: %s", name, st.Message())
 				return
 			}
-			logger.Log.Infof("process recent expired: %v", err)
+			logger.Log.Infof("%s: %v", name, err)
 			return
 		}
-		logger.Log.Infof("scheduler: process recent expired ok")
+		logger.Log.Infof("scheduler: %s ok", name)
+	}
+
+	run := func() {
+		call("process recent expired", func(ctx context.Context) error {
+			_, err := client.ProcessRecentExpired(ctx, &emptypb.Empty{})
+			return err
+		})
+		call("materialize recurring", func(ctx context.Context) error {
+			_, err := client.MaterializeRecurring(ctx, &emptypb.Empty{})
+			return err
+		})
+		call("send due reminders", func(ctx context.Context) error {
+			_, err := client.SendDueReminders(ctx, &emptypb.Empty{})
+			return err
+		})
+		call("purge trash", func(ctx context.Context) error {
+			_, err := client.PurgeTrash(ctx, &emptypb.Empty{})
+			return err
+		})
+		call("purge idempotency keys", func(ctx context.Context) error {
+			_, err := client.PurgeIdempotencyKeys(ctx, &emptypb.Empty{})
+			return err
+		})
+		call("purge attachments", func(ctx context.Context) error {
+			_, err := client.PurgeAttachments(ctx, &emptypb.Empty{})
+			return err
+		})
 	}
 
 	run()
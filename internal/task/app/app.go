@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -13,13 +14,16 @@ import (
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	accountpb "task-tracker/gen/private/account"
 	schedulerpb "task-tracker/gen/private/scheduler"
 	taskpb "task-tracker/gen/public/task"
 	"task-tracker/internal/task/config"
 	"task-tracker/internal/task/repo"
 	transportgrpc "task-tracker/internal/task/transport/grpc"
 	"task-tracker/internal/task/usecase"
+	"task-tracker/pkg/blob"
 	"task-tracker/pkg/db"
 	pkgjwt "task-tracker/pkg/jwt"
 	"task-tracker/pkg/kafka"
@@ -42,6 +46,12 @@ func Run() {
 	}()
 
 	taskRepo := repo.NewTaskRepository(dbConn)
+	projectRepo := repo.NewProjectRepository(dbConn)
+	commentRepo := repo.NewCommentRepository(dbConn)
+	timeEntryRepo := repo.NewTimeEntryRepository(dbConn)
+	templateRepo := repo.NewTaskTemplateRepository(dbConn)
+	attachmentRepo := repo.NewAttachmentRepository(dbConn)
+	idempotencyRepo := repo.NewIdempotencyRepository(dbConn)
 	parser := pkgjwt.Parser{Secret: []byte(cfg.JWTSecret)}
 
 	writer, err := kafka.NewWriter(cfg.KafkaBroker, cfg.KafkaTopic)
@@ -54,13 +64,61 @@ func Run() {
 		}
 	}()
 
-	publisher := taskkafka.NewPublisher(writer)
-	taskSvc := usecase.NewTaskService(&taskRepo, parser, publisher)
-	taskHandler := transportgrpc.NewTaskHandler(taskSvc)
-	schedulerHandler := transportgrpc.NewSchedulerHandler(taskSvc)
+	reminderWriter, err := kafka.NewWriter(cfg.KafkaBroker, cfg.KafkaReminderTopic)
+	if err != nil {
+		logger.Log.Fatalf("init kafka reminder writer: %v", err)
+	}
+	defer func() {
+		if err := reminderWriter.Close(); err != nil {
+			logger.Log.Infof("close kafka reminder writer: %v", err)
+		}
+	}()
 
-	server := grpc.NewServer(grpc.UnaryInterceptor(loggingUnaryServerInterceptor))
+	dependencyWriter, err := kafka.NewWriter(cfg.KafkaBroker, cfg.KafkaDependencyTopic)
+	if err != nil {
+		logger.Log.Fatalf("init kafka dependency writer: %v", err)
+	}
+	defer func() {
+		if err := dependencyWriter.Close(); err != nil {
+			logger.Log.Infof("close kafka dependency writer: %v", err)
+		}
+	}()
+
+	accountConn, err := grpc.NewClient(cfg.AccountGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Log.Fatalf("dial account grpc: %v", err)
+	}
+	defer func() {
+		if err := accountConn.Close(); err != nil {
+			logger.Log.Infof("close account grpc: %v", err)
+		}
+	}()
+	accountClient := transportgrpc.NewAccountClientAdapter(accountpb.NewUsersServiceClient(accountConn))
+
+	publisher := taskkafka.NewPublisher(writer, reminderWriter, dependencyWriter)
+	taskSvc := usecase.NewTaskService(&taskRepo, &projectRepo, &commentRepo, &timeEntryRepo, &templateRepo, parser, publisher, cfg.TrashRetention)
+	projectSvc := usecase.NewProjectService(&projectRepo, parser, accountClient)
+	idempotencySvc := usecase.NewIdempotencyService(&idempotencyRepo, parser, cfg.IdempotencyLease, cfg.IdempotencyTTL)
+
+	blobs, err := newBlobStore(cfg)
+	if err != nil {
+		logger.Log.Fatalf("init blob store: %v", err)
+	}
+	attachmentSvc := usecase.NewAttachmentService(taskSvc, &attachmentRepo, blobs, parser, cfg.AttachmentMaxSize)
+	calendarSvc := usecase.NewCalendarService(&taskRepo, accountClient)
+	taskHandler := transportgrpc.NewTaskHandler(taskSvc, attachmentSvc, calendarSvc)
+	projectHandler := transportgrpc.NewProjectHandler(projectSvc)
+	schedulerHandler := transportgrpc.NewSchedulerHandler(taskSvc, idempotencySvc, attachmentSvc)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			loggingUnaryServerInterceptor,
+			transportgrpc.IdempotencyInterceptor(idempotencySvc),
+		),
+		grpc.StreamInterceptor(loggingStreamServerInterceptor),
+	)
 	taskpb.RegisterTaskServiceServer(server, taskHandler)
+	taskpb.RegisterProjectServiceServer(server, projectHandler)
 	schedulerpb.RegisterSchedulerServiceServer(server, schedulerHandler)
 
 	lis, err := net.Listen("tcp", cfg.GRPCAddr)
@@ -114,3 +172,33 @@ func loggingUnaryServerInterceptor(ctx context.Context, req any, info *grpc.Unar
 	logger.Log.Infof("grpc request: method=%s duration=%s ok", info.FullMethod, time.Since(start))
 	return resp, nil
 }
+
+func loggingStreamServerInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	if err := handler(srv, stream); err != nil {
+		logger.Log.Infof("grpc stream: method=%s duration=%s err=%v", info.FullMethod, time.Since(start), err)
+		return err
+	}
+	logger.Log.Infof("grpc stream: method=%s duration=%s ok", info.FullMethod, time.Since(start))
+	return nil
+}
+
+func newBlobStore(cfg config.Config) (usecase.BlobStore, error) {
+	switch cfg.AttachmentStore {
+	case "local":
+		return blob.NewLocalStore(cfg.AttachmentDir)
+	case "s3":
+		store, err := blob.NewS3Store(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := store.EnsureBucket(ctx); err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown attachment store %q", cfg.AttachmentStore)
+	}
+}
@@ -1,24 +1,73 @@
 package config
 
-import "task-tracker/pkg/env"
+import (
+	"time"
+
+	"task-tracker/pkg/blob"
+	"task-tracker/pkg/env"
+)
 
 type Config struct {
-	GRPCAddr    string
-	DBDriver    string
-	DBDSN       string
-	JWTSecret   string
-	KafkaBroker string
-	KafkaTopic  string
+	GRPCAddr             string
+	DBDriver             string
+	DBDSN                string
+	JWTSecret            string
+	KafkaBroker          string
+	KafkaTopic           string
+	KafkaReminderTopic   string
+	KafkaDependencyTopic string
+	AccountGRPCAddr      string
+	TrashRetention       time.Duration
+	IdempotencyLease     time.Duration
+	IdempotencyTTL       time.Duration
+	AttachmentStore      string
+	AttachmentDir        string
+	AttachmentMaxSize    int64
+	S3                   blob.S3Config
 }
 
 func Load() (Config, error) {
+	trashRetention, err := env.GetEnvAsDuration("TRASH_RETENTION", 30*24*time.Hour)
+	if err != nil {
+		return Config{}, err
+	}
+	idempotencyLease, err := env.GetEnvAsDuration("IDEMPOTENCY_LEASE", time.Minute)
+	if err != nil {
+		return Config{}, err
+	}
+	idempotencyTTL, err := env.GetEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour)
+	if err != nil {
+		return Config{}, err
+	}
+
+	attachmentMaxSize, err := env.GetEnvAsInt("ATTACHMENT_MAX_SIZE", 20<<20)
+	if err != nil {
+		return Config{}, err
+	}
+
 	cfg := Config{
-		GRPCAddr:    env.GetEnvOrDefault("GRPC_ADDR", ":50052"),
-		DBDriver:    env.GetEnvOrDefault("DB_DRIVER", "pgx"),
-		DBDSN:       env.GetEnvOrDefault("DB_DSN", "pgsql:host=localhost port=5433 dbname=testdb user=admin password=secret"),
-		JWTSecret:   env.GetEnvOrDefault("JWT_SECRET", "secret"),
-		KafkaBroker: env.GetEnvOrDefault("KAFKA_BROKER", "localhost:9092"),
-		KafkaTopic:  env.GetEnvOrDefault("KAFKA_TOPIC", "task-expired-summary"),
+		GRPCAddr:             env.GetEnvOrDefault("GRPC_ADDR", ":50052"),
+		DBDriver:             env.GetEnvOrDefault("DB_DRIVER", "pgx"),
+		DBDSN:                env.GetEnvOrDefault("DB_DSN", "pgsql:host=localhost port=5433 dbname=testdb user=admin password=secret"),
+		JWTSecret:            env.GetEnvOrDefault("JWT_SECRET", "secret"),
+		KafkaBroker:          env.GetEnvOrDefault("KAFKA_BROKER", "localhost:9092"),
+		KafkaTopic:           env.GetEnvOrDefault("KAFKA_TOPIC", "task-expired-summary"),
+		KafkaReminderTopic:   env.GetEnvOrDefault("KAFKA_REMINDER_TOPIC", "task-reminders"),
+		KafkaDependencyTopic: env.GetEnvOrDefault("KAFKA_DEPENDENCY_TOPIC", "task-blocker-completed"),
+		AccountGRPCAddr:      env.GetEnvOrDefault("ACCOUNT_GRPC_ADDR", "localhost:50051"),
+		TrashRetention:       trashRetention,
+		IdempotencyLease:     idempotencyLease,
+		IdempotencyTTL:       idempotencyTTL,
+		AttachmentStore:      env.GetEnvOrDefault("ATTACHMENT_STORE", "local"),
+		AttachmentDir:        env.GetEnvOrDefault("ATTACHMENT_DIR", "data/attachments"),
+		AttachmentMaxSize:    int64(attachmentMaxSize),
+		S3: blob.S3Config{
+			Endpoint:  env.GetEnvOrDefault("S3_ENDPOINT", "http://localhost:9000"),
+			Region:    env.GetEnvOrDefault("S3_REGION", "us-east-1"),
+			Bucket:    env.GetEnvOrDefault("S3_BUCKET", "task-attachments"),
+			AccessKey: env.GetEnvOrDefault("S3_ACCESS_KEY", ""),
+			SecretKey: env.GetEnvOrDefault("S3_SECRET_KEY", ""),
+		},
 	}
 	return cfg, nil
 }
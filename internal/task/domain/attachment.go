@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const MaxAttachmentNameLength = 255
+
+var (
+	ErrAttachmentTooLarge     = errors.New("attachment too large")
+	ErrUnsupportedContentType = errors.New("unsupported attachment content type")
+)
+
+var attachmentContentTypes = map[string]struct{}{
+	"application/pdf": {},
+	"image/gif":       {},
+	"image/jpeg":      {},
+	"image/png":       {},
+	"image/webp":      {},
+	"text/plain":      {},
+}
+
+type Attachment struct {
+	ID          int64
+	TaskID      int64
+	UserID      int64
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+func AttachmentContentTypeAllowed(contentType string) bool {
+	_, ok := attachmentContentTypes[contentType]
+	return ok
+}
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment Attachment) (Attachment, error)
+	GetByIDAndTaskID(ctx context.Context, id, taskID int64) (Attachment, error)
+	GetByTaskID(ctx context.Context, taskID int64) ([]Attachment, error)
+	DeleteByID(ctx context.Context, id int64) error
+	GetOrphaned(ctx context.Context, limit int) ([]Attachment, error)
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const MaxBatchSize = 500
+
+var ErrBatchAborted = errors.New("batch aborted")
+
+type BatchAction int
+
+const (
+	BATCH_SET_STATUS BatchAction = iota + 1
+	BATCH_SET_DUE_DATE
+	BATCH_MOVE_TO_PROJECT
+	BATCH_SET_TAGS
+	BATCH_DELETE
+)
+
+type BatchMode int
+
+const (
+	BATCH_ALL_OR_NOTHING BatchMode = iota
+	BATCH_BEST_EFFORT
+)
+
+// BatchUpdate describes a single change applied to every selected task.
+// Only the fields that belong to Action are used.
+type BatchUpdate struct {
+	Action    BatchAction
+	Status    StatusChange
+	DueDate   time.Time
+	ProjectID int64
+	Tags      []string
+}
+
+type BatchResult struct {
+	ID   int64
+	Task Task
+	Err  error
+}
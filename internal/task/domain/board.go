@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// MaxRankLength bounds rank growth; a move that would produce a longer rank
+// re-ranks its whole column instead.
+const MaxRankLength = 64
+
+var (
+	ErrInvalidPosition = errors.New("neighbour task is not in the target column")
+	ErrInvalidRank     = errors.New("invalid board rank")
+)
+
+// BoardStatuses are the columns of a board, left to right.
+var BoardStatuses = []TaskStatus{CREATED, AT_WORK, COMPLETED}
+
+// Board is either the board of a project or the personal board of a user,
+// which holds their top-level tasks outside any project.
+type Board struct {
+	ProjectID int64
+	UserID    int64
+}
+
+func BoardOf(task Task) Board {
+	if task.ProjectID != 0 {
+		return Board{ProjectID: task.ProjectID}
+	}
+	return Board{UserID: task.UserID}
+}
+
+type BoardColumn struct {
+	Status TaskStatus
+	Tasks  []Task
+	Total  int
+}
+
+func IsBoardStatus(status TaskStatus) bool {
+	for _, s := range BoardStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Ranks are strings over rankDigits compared byte by byte. They never end in
+// the lowest digit, so there is always room for a rank before any other one.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// RankBetween returns a rank sorting strictly between prev and next. An empty
+// prev stands for the top of the column and an empty next for its bottom.
+func RankBetween(prev, next string) (string, error) {
+	if !validRank(prev) || !validRank(next) || (next != "" && prev >= next) {
+		return "", ErrInvalidRank
+	}
+	return rankMidpoint(prev, next), nil
+}
+
+// RanksAfter returns n increasing ranks that all sort after prev.
+func RanksAfter(prev string, n int) ([]string, error) {
+	start, err := RankBetween(prev, "")
+	if err != nil {
+		return nil, err
+	}
+
+	width := 1
+	for capacity := len(rankDigits); capacity < n; capacity *= len(rankDigits) {
+		width++
+	}
+	ranks := make([]string, n)
+	for i := range ranks {
+		ranks[i] = start + encodeRank(i, width) + rankMidpoint("", "")
+	}
+	return ranks, nil
+}
+
+func rankMidpoint(prev, next string) string {
+	if next != "" {
+		n := 0
+		for n < len(next) && rankDigitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			return next[:n] + rankMidpoint(rankSuffix(prev, n), next[n:])
+		}
+	}
+
+	lo := strings.IndexByte(rankDigits, rankDigitAt(prev, 0))
+	hi := len(rankDigits)
+	if next != "" {
+		hi = strings.IndexByte(rankDigits, next[0])
+	}
+	if hi-lo > 1 {
+		return rankDigits[(lo+hi)/2 : (lo+hi)/2+1]
+	}
+	if len(next) > 1 {
+		return next[:1]
+	}
+	return rankDigits[lo:lo+1] + rankMidpoint(rankSuffix(prev, 1), "")
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func rankSuffix(rank string, n int) string {
+	if n < len(rank) {
+		return rank[n:]
+	}
+	return ""
+}
+
+func encodeRank(value, width int) string {
+	digits := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		digits[i] = rankDigits[value%len(rankDigits)]
+		value /= len(rankDigits)
+	}
+	return string(digits)
+}
+
+func validRank(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return rank == "" || rank[len(rank)-1] != rankDigits[0]
+}
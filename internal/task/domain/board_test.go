@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    string
+		next    string
+		wantErr error
+	}{
+		{name: "empty column", prev: "", next: ""},
+		{name: "top of column", prev: "", next: "i"},
+		{name: "bottom of column", prev: "i", next: ""},
+		{name: "wide gap", prev: "a", next: "z"},
+		{name: "adjacent digits", prev: "a", next: "b"},
+		{name: "common prefix", prev: "a1", next: "a2"},
+		{name: "next extends prev", prev: "a", next: "a01"},
+		{name: "before lowest single digit", prev: "", next: "1"},
+		{name: "after highest digit", prev: "z", next: ""},
+		{name: "equal ranks", prev: "a", next: "a", wantErr: ErrInvalidRank},
+		{name: "reversed ranks", prev: "b", next: "a", wantErr: ErrInvalidRank},
+		{name: "trailing lowest digit", prev: "a0", next: "", wantErr: ErrInvalidRank},
+		{name: "invalid digit", prev: "", next: "A", wantErr: ErrInvalidRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RankBetween(tt.prev, tt.next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RankBetween(%q, %q) error = %v, want %v", tt.prev, tt.next, err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !validRank(got) || got == "" {
+				t.Fatalf("RankBetween(%q, %q) = %q, not a valid rank", tt.prev, tt.next, got)
+			}
+			if got <= tt.prev || (tt.next != "" && got >= tt.next) {
+				t.Fatalf("RankBetween(%q, %q) = %q, not strictly between", tt.prev, tt.next, got)
+			}
+		})
+	}
+}
+
+// TestRankBetweenExhaustion inserts repeatedly at the same spot, which is what
+// makes ranks grow until the repository has to re-rank the column.
+func TestRankBetweenExhaustion(t *testing.T) {
+	tests := []struct {
+		name string
+		// insert returns the new rank placed against the previous one.
+		insert func(last string) (string, error)
+	}{
+		{name: "always at the top", insert: func(last string) (string, error) { return RankBetween("", last) }},
+		{name: "always right after a fixed task", insert: func(last string) (string, error) { return RankBetween("i", last) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			last := "z"
+			for i := 0; len(last) <= MaxRankLength; i++ {
+				if i > 10*MaxRankLength*len(rankDigits) {
+					t.Fatalf("rank still %d long after %d inserts", len(last), i)
+				}
+				rank, err := tt.insert(last)
+				if err != nil {
+					t.Fatalf("insert %d before %q: %v", i, last, err)
+				}
+				if rank >= last || !validRank(rank) {
+					t.Fatalf("insert %d before %q = %q, out of order", i, last, rank)
+				}
+				last = rank
+			}
+		})
+	}
+}
+
+func TestRanksAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    string
+		n       int
+		wantErr error
+	}{
+		{name: "none", prev: "", n: 0},
+		{name: "one", prev: "", n: 1},
+		{name: "one digit wide", prev: "", n: len(rankDigits)},
+		{name: "two digits wide", prev: "", n: len(rankDigits) + 1},
+		{name: "large column", prev: "", n: 5000},
+		{name: "after ranked tasks", prev: "k3", n: 100},
+		{name: "after highest rank", prev: "zzz", n: 10},
+		{name: "after invalid rank", prev: "a0", n: 1, wantErr: ErrInvalidRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RanksAfter(tt.prev, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RanksAfter(%q, %d) error = %v, want %v", tt.prev, tt.n, err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got) != tt.n {
+				t.Fatalf("RanksAfter(%q, %d) returned %d ranks", tt.prev, tt.n, len(got))
+			}
+			prev := tt.prev
+			for i, rank := range got {
+				if !validRank(rank) || rank <= prev {
+					t.Fatalf("rank %d = %q, not a valid rank after %q", i, rank, prev)
+				}
+				if len(rank) > MaxRankLength {
+					t.Fatalf("rank %d = %q, longer than %d", i, rank, MaxRankLength)
+				}
+				prev = rank
+			}
+		})
+	}
+}
+
+// TestRerankLeavesRoom checks that a freshly re-ranked column has room for
+// inserts again between any two neighbours.
+func TestRerankLeavesRoom(t *testing.T) {
+	ranks, err := RanksAfter("", 1000)
+	if err != nil {
+		t.Fatalf("RanksAfter: %v", err)
+	}
+	for i := 1; i < len(ranks); i++ {
+		rank, err := RankBetween(ranks[i-1], ranks[i])
+		if err != nil {
+			t.Fatalf("RankBetween(%q, %q): %v", ranks[i-1], ranks[i], err)
+		}
+		if len(rank) > len(ranks[i])+1 {
+			t.Fatalf("RankBetween(%q, %q) = %q, grew by more than one digit", ranks[i-1], ranks[i], rank)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const MaxCommentLength = 10000
+
+type Comment struct {
+	ID        int64
+	TaskID    int64
+	UserID    int64
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment Comment) (Comment, error)
+	GetByIDAndTaskID(ctx context.Context, id, taskID int64) (Comment, error)
+	GetByTaskID(ctx context.Context, taskID, afterID int64, limit int) ([]Comment, error)
+	UpdateByIDAndUserID(ctx context.Context, id, userID int64, body string, updatedAt time.Time) (Comment, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID int64) error
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "errors"
+
+const MaxBlockers = 50
+
+var (
+	ErrDependencyCycle = errors.New("task dependency would create a cycle")
+	ErrTaskBlocked     = errors.New("task is blocked by incomplete tasks")
+)
+
+// Blocked reports whether any live blocker of the task is not completed yet.
+func (t Task) Blocked() bool {
+	return t.OpenBlockers > 0
+}
+
+// BlockerCompleted tells the owner of a dependent task that one of its
+// blockers has been completed. Remaining counts the blockers still open.
+type BlockerCompleted struct {
+	TaskID             int64
+	UserID             int64
+	AssigneeID         int64
+	Description        string
+	BlockerID          int64
+	BlockerDescription string
+	Remaining          int
+}
@@ -0,0 +1,449 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxDueTextLength = 100
+
+var (
+	ErrInvalidDueText   = errors.New("cannot parse due text")
+	ErrAmbiguousDueText = errors.New("ambiguous due text")
+)
+
+var (
+	isoDatePattern    = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	dottedDatePattern = regexp.MustCompile(`^(\d{1,2})\.(\d{1,2})(?:\.(\d{4}))?$`)
+	slashDatePattern  = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{4}))?$`)
+	clockPattern      = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	yearPattern       = regexp.MustCompile(`^\d{4}$`)
+)
+
+// dueFillers are words that carry no meaning of their own: "at 5pm",
+// "on friday", "в пятницу", "к 17:00".
+var dueFillers = map[string]bool{
+	"at": true, "on": true, "by": true,
+	"в": true, "во": true, "на": true, "к": true, "до": true,
+}
+
+var dueRelativeDays = map[string]int{
+	"today": 0, "tomorrow": 1,
+	"сегодня": 0, "завтра": 1, "послезавтра": 2,
+}
+
+var dueThisWords = map[string]bool{
+	"this": true, "эту": true, "этот": true, "это": true, "эта": true,
+}
+
+var dueNextWords = map[string]bool{
+	"next": true, "следующий": true, "следующую": true, "следующее": true,
+}
+
+var dueWeekdays = map[string]time.Weekday{
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"понедельник": time.Monday, "пн": time.Monday,
+	"вторник": time.Tuesday, "вт": time.Tuesday,
+	"среда": time.Wednesday, "среду": time.Wednesday, "ср": time.Wednesday,
+	"четверг": time.Thursday, "чт": time.Thursday,
+	"пятница": time.Friday, "пятницу": time.Friday, "пт": time.Friday,
+	"суббота": time.Saturday, "субботу": time.Saturday, "сб": time.Saturday,
+	"воскресенье": time.Sunday, "вс": time.Sunday,
+}
+
+var dueMonths = map[string]time.Month{
+	"jan": time.January, "january": time.January, "января": time.January,
+	"feb": time.February, "february": time.February, "февраля": time.February,
+	"mar": time.March, "march": time.March, "марта": time.March,
+	"apr": time.April, "april": time.April, "апреля": time.April,
+	"may": time.May, "мая": time.May,
+	"jun": time.June, "june": time.June, "июня": time.June,
+	"jul": time.July, "july": time.July, "июля": time.July,
+	"aug": time.August, "august": time.August, "августа": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September, "сентября": time.September,
+	"oct": time.October, "october": time.October, "октября": time.October,
+	"nov": time.November, "november": time.November, "ноября": time.November,
+	"dec": time.December, "december": time.December, "декабря": time.December,
+}
+
+var dueNumbers = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+	"один": 1, "одну": 1, "два": 2, "две": 2, "три": 3, "четыре": 4, "пять": 5,
+	"шесть": 6, "семь": 7, "восемь": 8, "девять": 9, "десять": 10,
+}
+
+type dueUnit int
+
+const (
+	dueMinutes dueUnit = iota + 1
+	dueHours
+	dueDays
+	dueWeeks
+	dueMonthsUnit
+)
+
+var dueUnits = map[string]dueUnit{
+	"minute": dueMinutes, "minutes": dueMinutes, "min": dueMinutes, "mins": dueMinutes,
+	"минуту": dueMinutes, "минуты": dueMinutes, "минут": dueMinutes,
+	"hour": dueHours, "hours": dueHours, "hr": dueHours, "hrs": dueHours,
+	"час": dueHours, "часа": dueHours, "часов": dueHours,
+	"day": dueDays, "days": dueDays,
+	"день": dueDays, "дня": dueDays, "дней": dueDays,
+	"week": dueWeeks, "weeks": dueWeeks,
+	"неделю": dueWeeks, "недели": dueWeeks, "недель": dueWeeks,
+	"month": dueMonthsUnit, "months": dueMonthsUnit,
+	"месяц": dueMonthsUnit, "месяца": dueMonthsUnit, "месяцев": dueMonthsUnit,
+}
+
+// dueMeridiems map the words that may follow an hour to whether they mean
+// the afternoon: "5 pm", "5 вечера", "3 дня", "7 утра".
+var dueMeridiems = map[string]bool{
+	"am": false, "pm": true,
+	"утра": false, "ночи": false, "дня": true, "вечера": true,
+}
+
+const maxDueAmount = 999
+
+// ParseDueText resolves a due date written in English or Russian, such as
+// "tomorrow 5pm", "next friday", "in 3 days" or "через 2 дня", relative to
+// now and in now's location. A day without a time of day is due at its end,
+// like a due day. A bare "friday" means the first Friday after today and
+// "this friday" the first one from today on. "next friday" means the Friday
+// of next week, and is ambiguous while this week's Friday is still ahead.
+//
+// Input that can be read in more than one way, like "05/06", "at 5" or
+// "next friday" on a Monday, is rejected with ErrAmbiguousDueText; anything
+// else that cannot be parsed, or that resolves to the past, with
+// ErrInvalidDueText.
+func ParseDueText(text string, now time.Time) (time.Time, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > MaxDueTextLength {
+		return time.Time{}, ErrInvalidDueText
+	}
+	normalized := strings.NewReplacer(",", " ", "a.m.", "am", "p.m.", "pm", "ё", "е").Replace(strings.ToLower(text))
+
+	p := dueParser{text: text, now: now, words: strings.Fields(normalized)}
+	for p.pos < len(p.words) {
+		if err := p.next(); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return p.resolve()
+}
+
+type dueParser struct {
+	text  string
+	now   time.Time
+	words []string
+	pos   int
+
+	day        time.Time
+	hasDay     bool
+	hour       int
+	minute     int
+	hasClock   bool
+	instant    time.Time
+	hasInstant bool
+}
+
+func (p *dueParser) next() error {
+	word := p.words[p.pos]
+	if dueFillers[word] {
+		p.pos++
+		return nil
+	}
+	if word == "in" || word == "через" {
+		return p.parseIn()
+	}
+	if days, ok := dueRelativeDays[word]; ok {
+		p.pos++
+		return p.setDay(p.today().AddDate(0, 0, days))
+	}
+	if word == "day" && p.peek(1) == "after" && p.peek(2) == "tomorrow" {
+		p.pos += 3
+		return p.setDay(p.today().AddDate(0, 0, 2))
+	}
+	if dueThisWords[word] {
+		weekday, ok := dueWeekdays[p.peek(1)]
+		if !ok {
+			return p.unknown(word)
+		}
+		p.pos += 2
+		return p.setDay(thisWeekday(p.today(), weekday))
+	}
+	if dueNextWords[word] {
+		weekday, ok := dueWeekdays[p.peek(1)]
+		if !ok {
+			return p.unknown(word)
+		}
+		day := nextWeekday(p.today(), weekday)
+		if sameWeek(p.today(), day) {
+			return fmt.Errorf("%w: %q can be %s or %s; use \"this\" or a date", ErrAmbiguousDueText, word+" "+p.peek(1),
+				day.Format("Mon Jan 2"), day.AddDate(0, 0, 7).Format("Mon Jan 2"))
+		}
+		p.pos += 2
+		return p.setDay(day)
+	}
+	if weekday, ok := dueWeekdays[word]; ok {
+		p.pos++
+		return p.setDay(nextWeekday(p.today(), weekday))
+	}
+	if word == "noon" || word == "полдень" {
+		p.pos++
+		return p.setClock(12, 0)
+	}
+
+	if ok, err := p.parseDate(); ok || err != nil {
+		return err
+	}
+	if ok, err := p.parseClock(); ok || err != nil {
+		return err
+	}
+	return p.unknown(word)
+}
+
+// parseIn reads "in 3 days", "in an hour", "через 2 дня" or "через неделю".
+func (p *dueParser) parseIn() error {
+	p.pos++
+	amount := 1
+	explicit := false
+	if n, err := strconv.Atoi(p.peek(0)); err == nil {
+		amount, explicit = n, true
+	} else if n, ok := dueNumbers[p.peek(0)]; ok {
+		amount, explicit = n, true
+	}
+	if explicit {
+		p.pos++
+	}
+	unit, ok := dueUnits[p.peek(0)]
+	if !ok {
+		return fmt.Errorf("%w: expected a unit such as \"days\" or \"hours\" in %q", ErrInvalidDueText, p.text)
+	}
+	p.pos++
+	if amount <= 0 || amount > maxDueAmount {
+		return fmt.Errorf("%w: amount %d is out of range in %q", ErrInvalidDueText, amount, p.text)
+	}
+
+	switch unit {
+	case dueMinutes:
+		return p.setInstant(p.now.Add(time.Duration(amount) * time.Minute))
+	case dueHours:
+		return p.setInstant(p.now.Add(time.Duration(amount) * time.Hour))
+	case dueDays:
+		return p.setDay(p.today().AddDate(0, 0, amount))
+	case dueWeeks:
+		return p.setDay(p.today().AddDate(0, 0, 7*amount))
+	default:
+		return p.setDay(p.today().AddDate(0, amount, 0))
+	}
+}
+
+// parseDate reads "2026-10-20", "20.10", "20.10.2026", "10/25", "oct 20",
+// "20 oct" and "20 октября", the last three optionally followed by a year.
+func (p *dueParser) parseDate() (bool, error) {
+	word := p.words[p.pos]
+	if isoDatePattern.MatchString(word) {
+		day, err := time.ParseInLocation("2006-01-02", word, p.now.Location())
+		if err != nil {
+			return true, fmt.Errorf("%w: invalid date %q", ErrInvalidDueText, word)
+		}
+		p.pos++
+		return true, p.setDay(day)
+	}
+	if m := dottedDatePattern.FindStringSubmatch(word); m != nil {
+		p.pos++
+		return true, p.setDate(atoi(m[1]), time.Month(atoi(m[2])), atoi(m[3]), word)
+	}
+	if m := slashDatePattern.FindStringSubmatch(word); m != nil {
+		first, second := atoi(m[1]), atoi(m[2])
+		if first <= 12 && second <= 12 && first != second {
+			return true, fmt.Errorf("%w: %q can be %s %d or %s %d; use YYYY-MM-DD", ErrAmbiguousDueText, word,
+				time.Month(first), second, time.Month(second), first)
+		}
+		p.pos++
+		if first > 12 {
+			return true, p.setDate(first, time.Month(second), atoi(m[3]), word)
+		}
+		return true, p.setDate(second, time.Month(first), atoi(m[3]), word)
+	}
+	if month, ok := dueMonths[word]; ok {
+		if day, err := strconv.Atoi(p.peek(1)); err == nil {
+			return true, p.setMonthDate(day, month)
+		}
+	}
+	if month, ok := dueMonths[p.peek(1)]; ok {
+		if day, err := strconv.Atoi(word); err == nil {
+			return true, p.setMonthDate(day, month)
+		}
+	}
+	return false, nil
+}
+
+// setMonthDate consumes a day and a month name in either order, and the
+// year that may follow them.
+func (p *dueParser) setMonthDate(day int, month time.Month) error {
+	words := p.words[p.pos : p.pos+2]
+	p.pos += 2
+	year := 0
+	if yearPattern.MatchString(p.peek(0)) {
+		year = atoi(p.peek(0))
+		words = p.words[p.pos-2 : p.pos+1]
+		p.pos++
+	}
+	return p.setDate(day, month, year, strings.Join(words, " "))
+}
+
+// parseClock reads "17:00", "5pm", "5:30 pm", "5 вечера" and "20". A bare
+// hour from 1 to 12 is ambiguous.
+func (p *dueParser) parseClock() (bool, error) {
+	word := p.words[p.pos]
+	m := clockPattern.FindStringSubmatch(word)
+	if m == nil {
+		return false, nil
+	}
+	hour, minute := atoi(m[1]), atoi(m[2])
+	p.pos++
+
+	meridiem, pm := m[3], m[3] == "pm"
+	if meridiem == "" {
+		if afternoon, ok := dueMeridiems[p.peek(0)]; ok {
+			meridiem, pm = p.peek(0), afternoon
+			p.pos++
+		}
+	}
+
+	switch {
+	case meridiem != "":
+		if hour < 1 || hour > 12 {
+			return true, fmt.Errorf("%w: invalid hour %d %s in %q", ErrInvalidDueText, hour, meridiem, p.text)
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	case m[2] == "" && hour >= 1 && hour <= 12:
+		return true, fmt.Errorf("%w: %q can be %d am or %d pm; add am/pm or use 24-hour time like %d:00", ErrAmbiguousDueText, word, hour, hour, hour+12)
+	}
+	if hour > 23 || minute > 59 {
+		return true, fmt.Errorf("%w: invalid time %q in %q", ErrInvalidDueText, word, p.text)
+	}
+	return true, p.setClock(hour, minute)
+}
+
+func (p *dueParser) setDate(day int, month time.Month, year int, word string) error {
+	today := p.today()
+	explicitYear := year != 0
+	if !explicitYear {
+		year = today.Year()
+	}
+	date := time.Date(year, month, day, 0, 0, 0, 0, today.Location())
+	if date.Day() != day || date.Month() != month {
+		return fmt.Errorf("%w: invalid date %q", ErrInvalidDueText, word)
+	}
+	if !explicitYear && date.Before(today) {
+		date = date.AddDate(1, 0, 0)
+	}
+	return p.setDay(date)
+}
+
+func (p *dueParser) setDay(day time.Time) error {
+	if p.hasDay || p.hasInstant {
+		return fmt.Errorf("%w: more than one date in %q", ErrInvalidDueText, p.text)
+	}
+	p.day, p.hasDay = day, true
+	return nil
+}
+
+func (p *dueParser) setClock(hour, minute int) error {
+	if p.hasClock || p.hasInstant {
+		return fmt.Errorf("%w: more than one time in %q", ErrInvalidDueText, p.text)
+	}
+	p.hour, p.minute, p.hasClock = hour, minute, true
+	return nil
+}
+
+func (p *dueParser) setInstant(instant time.Time) error {
+	if p.hasDay || p.hasClock || p.hasInstant {
+		return fmt.Errorf("%w: \"in N hours\" cannot be combined with a date or time in %q", ErrInvalidDueText, p.text)
+	}
+	p.instant, p.hasInstant = instant, true
+	return nil
+}
+
+func (p *dueParser) resolve() (time.Time, error) {
+	var due time.Time
+	switch {
+	case p.hasInstant:
+		due = p.instant
+	case p.hasDay || p.hasClock:
+		day := p.today()
+		if p.hasDay {
+			day = p.day
+		}
+		if p.hasClock {
+			due = time.Date(day.Year(), day.Month(), day.Day(), p.hour, p.minute, 0, 0, day.Location())
+		} else {
+			due = day.AddDate(0, 0, 1).Add(-time.Second)
+		}
+	default:
+		return time.Time{}, fmt.Errorf("%w: no date or time in %q", ErrInvalidDueText, p.text)
+	}
+	if due.Before(p.now) {
+		return time.Time{}, fmt.Errorf("%w: %q is in the past", ErrInvalidDueText, p.text)
+	}
+	return due, nil
+}
+
+func (p *dueParser) today() time.Time {
+	year, month, day := p.now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, p.now.Location())
+}
+
+func (p *dueParser) peek(offset int) string {
+	if p.pos+offset < len(p.words) {
+		return p.words[p.pos+offset]
+	}
+	return ""
+}
+
+func (p *dueParser) unknown(word string) error {
+	return fmt.Errorf("%w: unknown word %q in %q", ErrInvalidDueText, word, p.text)
+}
+
+// thisWeekday returns the first day from today on that falls on weekday.
+func thisWeekday(today time.Time, weekday time.Weekday) time.Time {
+	return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7)
+}
+
+// sameWeek reports whether day falls in the Monday to Sunday week of today;
+// day is never before today.
+func sameWeek(today, day time.Time) bool {
+	daysLeft := 6 - (int(today.Weekday())+6)%7
+	return day.Before(today.AddDate(0, 0, daysLeft+1))
+}
+
+// nextWeekday returns the first day after today that falls on weekday.
+func nextWeekday(today time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDueText(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	// A Wednesday.
+	now := time.Date(2026, time.October, 14, 10, 30, 0, 0, msk)
+	endOf := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 23, 59, 59, 0, msk)
+	}
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, msk)
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    time.Time
+		wantErr error
+	}{
+		{name: "today", text: "today", want: endOf(2026, time.October, 14)},
+		{name: "tomorrow with time", text: "tomorrow 5pm", want: at(2026, time.October, 15, 17, 0)},
+		{name: "day after tomorrow", text: "day after tomorrow", want: endOf(2026, time.October, 16)},
+		{name: "russian day after tomorrow", text: "послезавтра в 9 утра", want: at(2026, time.October, 16, 9, 0)},
+		{name: "in days", text: "in 3 days", want: endOf(2026, time.October, 17)},
+		{name: "in hours", text: "in 2 hours", want: at(2026, time.October, 14, 12, 30)},
+		{name: "in a week", text: "in a week", want: endOf(2026, time.October, 21)},
+		{name: "in a month", text: "in a month", want: endOf(2026, time.November, 14)},
+		{name: "russian in days", text: "через 2 дня", want: endOf(2026, time.October, 16)},
+		{name: "russian in a week", text: "через неделю", want: endOf(2026, time.October, 21)},
+		{name: "in zero days", text: "in 0 days", wantErr: ErrInvalidDueText},
+		{name: "in without unit", text: "in 3", wantErr: ErrInvalidDueText},
+		{name: "in combined with date", text: "tomorrow in 2 hours", wantErr: ErrInvalidDueText},
+
+		{name: "bare weekday later this week", text: "friday", want: endOf(2026, time.October, 16)},
+		{name: "bare weekday of today", text: "wednesday", want: endOf(2026, time.October, 21)},
+		{name: "this weekday of today", text: "this wednesday", want: endOf(2026, time.October, 14)},
+		{name: "this weekday later this week", text: "this friday 18:00", want: at(2026, time.October, 16, 18, 0)},
+		{name: "this weekday already passed", text: "this monday", want: endOf(2026, time.October, 19)},
+		{name: "russian this weekday of today", text: "в эту среду", want: endOf(2026, time.October, 14)},
+		{name: "this without weekday", text: "this 5pm", wantErr: ErrInvalidDueText},
+		{name: "next weekday in next week", text: "next monday", want: endOf(2026, time.October, 19)},
+		{name: "next weekday of today", text: "next wednesday", want: endOf(2026, time.October, 21)},
+		{name: "next weekday still this week", text: "next friday", wantErr: ErrAmbiguousDueText},
+		{name: "next sunday", text: "next sunday", wantErr: ErrAmbiguousDueText},
+		{name: "russian next weekday still this week", text: "в следующую пятницу", wantErr: ErrAmbiguousDueText},
+		{name: "russian next weekday in next week", text: "в следующий понедельник", want: endOf(2026, time.October, 19)},
+
+		{name: "iso date", text: "2026-10-20", want: endOf(2026, time.October, 20)},
+		{name: "dotted date", text: "20.10", want: endOf(2026, time.October, 20)},
+		{name: "dotted date rolls over", text: "01.02", want: endOf(2027, time.February, 1)},
+		{name: "month name", text: "oct 20", want: endOf(2026, time.October, 20)},
+		{name: "month name rolls over", text: "10 oct", want: endOf(2027, time.October, 10)},
+		{name: "russian month name with year", text: "20 октября 2026", want: endOf(2026, time.October, 20)},
+		{name: "month name with year and time", text: "october 20, 2027 at 9am", want: at(2027, time.October, 20, 9, 0)},
+		{name: "month name with past year", text: "20 октября 2025", wantErr: ErrInvalidDueText},
+		{name: "invalid day of month", text: "31 nov", wantErr: ErrInvalidDueText},
+		{name: "ambiguous slash date", text: "05/06", wantErr: ErrAmbiguousDueText},
+		{name: "unambiguous slash date", text: "13/06", want: endOf(2027, time.June, 13)},
+
+		{name: "24-hour time", text: "17:00", want: at(2026, time.October, 14, 17, 0)},
+		{name: "noon", text: "tomorrow noon", want: at(2026, time.October, 15, 12, 0)},
+		{name: "russian evening", text: "завтра в 5 вечера", want: at(2026, time.October, 15, 17, 0)},
+		{name: "ambiguous hour", text: "at 5", wantErr: ErrAmbiguousDueText},
+		{name: "time in the past", text: "9:00", wantErr: ErrInvalidDueText},
+		{name: "two dates", text: "tomorrow friday", wantErr: ErrInvalidDueText},
+		{name: "unknown word", text: "yesterday", wantErr: ErrInvalidDueText},
+		{name: "empty", text: "  ", wantErr: ErrInvalidDueText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDueText(tt.text, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseDueText(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("ParseDueText(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
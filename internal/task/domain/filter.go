@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+type TaskSort int
+
+const (
+	SORT_DUE_DATE_ASC TaskSort = iota
+	SORT_DUE_DATE_DESC
+	SORT_CREATED_AT_ASC
+	SORT_CREATED_AT_DESC
+)
+
+type TaskCursor struct {
+	Value time.Time
+	ID    int64
+}
+
+type TaskFilter struct {
+	UserID       int64
+	Statuses     []TaskStatus
+	DueFrom      time.Time
+	DueTo        time.Time
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	Query        string
+	Priorities   []Priority
+	Tags         []string
+	ProjectID    int64
+	AssigneeID   int64
+	AssignedToMe bool
+	Sort         TaskSort
+	After        *TaskCursor
+	Limit        int
+}
+
+func (s TaskSort) Column() string {
+	switch s {
+	case SORT_CREATED_AT_ASC, SORT_CREATED_AT_DESC:
+		return "date"
+	default:
+		return "due_date"
+	}
+}
+
+func (s TaskSort) Desc() bool {
+	return s == SORT_DUE_DATE_DESC || s == SORT_CREATED_AT_DESC
+}
+
+func (s TaskSort) CursorOf(task Task) TaskCursor {
+	switch s {
+	case SORT_CREATED_AT_ASC, SORT_CREATED_AT_DESC:
+		return TaskCursor{Value: task.CreatedAt, ID: task.ID}
+	default:
+		return TaskCursor{Value: task.DueDate, ID: task.ID}
+	}
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type EventSource int
+
+const (
+	SOURCE_SYSTEM EventSource = iota
+	SOURCE_USER
+	SOURCE_SCHEDULER
+)
+
+type TaskEventType int
+
+const (
+	TASK_CREATED TaskEventType = iota
+	TASK_UPDATED
+	TASK_DELETED
+	TASK_RESTORED
+	TASK_PURGED
+)
+
+type Actor struct {
+	UserID int64
+	Source EventSource
+}
+
+type TaskEvent struct {
+	ID        int64
+	TaskID    int64
+	Type      TaskEventType
+	Field     string
+	OldValue  string
+	NewValue  string
+	ActorID   int64
+	Source    EventSource
+	CreatedAt time.Time
+}
+
+type actorKey struct{}
+
+func UserActor(userID int64) Actor {
+	return Actor{UserID: userID, Source: SOURCE_USER}
+}
+
+func SchedulerActor() Actor {
+	return Actor{Source: SOURCE_SCHEDULER}
+}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
+
+// DiffTask returns one TASK_UPDATED event per field that differs between the
+// two snapshots of the same task.
+func DiffTask(before, after Task) []TaskEvent {
+	var events []TaskEvent
+	add := func(field, oldValue, newValue string) {
+		if oldValue == newValue {
+			return
+		}
+		events = append(events, TaskEvent{
+			TaskID:   after.ID,
+			Type:     TASK_UPDATED,
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	add("description", before.Description, after.Description)
+	add("status", before.Status.String(), after.Status.String())
+	add("due_date", formatEventTime(before.DueDate), formatEventTime(after.DueDate))
+	add("priority", before.Priority.String(), after.Priority.String())
+	add("tags", strings.Join(before.Tags, ","), strings.Join(after.Tags, ","))
+	add("recurrence", before.Recurrence, after.Recurrence)
+	add("reminders", formatEventReminders(before.Reminders), formatEventReminders(after.Reminders))
+	add("project_id", formatEventID(before.ProjectID), formatEventID(after.ProjectID))
+	add("assignee_id", formatEventID(before.AssigneeID), formatEventID(after.AssigneeID))
+	return events
+}
+
+func FieldChanged(taskID int64, field string, oldValue, newValue int64) TaskEvent {
+	return TaskEvent{
+		TaskID:   taskID,
+		Type:     TASK_UPDATED,
+		Field:    field,
+		OldValue: formatEventID(oldValue),
+		NewValue: formatEventID(newValue),
+	}
+}
+
+func StatusChanged(taskID int64, from, to TaskStatus) TaskEvent {
+	return TaskEvent{
+		TaskID:   taskID,
+		Type:     TASK_UPDATED,
+		Field:    "status",
+		OldValue: from.String(),
+		NewValue: to.String(),
+	}
+}
+
+func formatEventTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatEventID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func formatEventReminders(reminders []time.Duration) string {
+	minutes := make([]string, 0, len(reminders))
+	for _, offset := range reminders {
+		minutes = append(minutes, strconv.FormatInt(int64(offset/time.Minute), 10))
+	}
+	return strings.Join(minutes, ",")
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const MaxIdempotencyKeyLength = 255
+
+var (
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyInProgress = errors.New("request with this idempotency key is in progress")
+)
+
+type IdempotencyRecord struct {
+	UserID      int64
+	Key         string
+	Method      string
+	RequestHash string
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, record IdempotencyRecord) (bool, error)
+	GetByUserIDAndKey(ctx context.Context, userID int64, key string) (IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, reservation IdempotencyRecord, response []byte, expiresAt time.Time) error
+	DeleteReservation(ctx context.Context, reservation IdempotencyRecord) error
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
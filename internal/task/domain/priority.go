@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+type Priority int
+
+const (
+	NO_PRIORITY Priority = iota
+	LOW
+	MEDIUM
+	HIGH
+	URGENT
+)
+
+const (
+	MaxTags      = 20
+	MaxTagLength = 50
+)
+
+var ErrInvalidTag = errors.New("invalid tag")
+
+var Priorities = []Priority{NO_PRIORITY, LOW, MEDIUM, HIGH, URGENT}
+
+func (p Priority) Valid() bool {
+	return p >= NO_PRIORITY && p <= URGENT
+}
+
+func (p Priority) String() string {
+	switch p {
+	case LOW:
+		return "low"
+	case MEDIUM:
+		return "medium"
+	case HIGH:
+		return "high"
+	case URGENT:
+		return "urgent"
+	default:
+		return "none"
+	}
+}
+
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) > MaxTags {
+		return nil, ErrInvalidTag
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len([]rune(tag)) > MaxTagLength {
+			return nil, ErrInvalidTag
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxProjectNameLength = 100
+
+var (
+	ErrProjectArchived     = errors.New("project is archived")
+	ErrInvalidProjectName  = errors.New("invalid project name")
+	ErrInvalidProjectColor = errors.New("invalid project color")
+)
+
+var projectColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type ProjectRole int
+
+const (
+	OWNER ProjectRole = iota
+	EDITOR
+	VIEWER
+)
+
+func (r ProjectRole) Valid() bool {
+	return r >= OWNER && r <= VIEWER
+}
+
+func (r ProjectRole) CanEdit() bool {
+	return r == OWNER || r == EDITOR
+}
+
+type Project struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Color     string
+	Archived  bool
+	CreatedAt time.Time
+	Role      ProjectRole
+	Counts    ProjectCounts
+}
+
+type ProjectMember struct {
+	ProjectID int64
+	UserID    int64
+	Email     string
+	Role      ProjectRole
+	CreatedAt time.Time
+}
+
+type ProjectCounts struct {
+	Total     int
+	Open      int
+	Completed int
+	Expired   int
+}
+
+func (c *ProjectCounts) Add(status TaskStatus, count int) {
+	c.Total += count
+	switch status {
+	case CREATED, AT_WORK:
+		c.Open += count
+	case COMPLETED:
+		c.Completed += count
+	case EXPIRED:
+		c.Expired += count
+	}
+}
+
+type ProjectUpdate struct {
+	Name     *string
+	Color    *string
+	Archived *bool
+}
+
+func (u ProjectUpdate) IsEmpty() bool {
+	return u.Name == nil && u.Color == nil && u.Archived == nil
+}
+
+func NormalizeProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxProjectNameLength {
+		return "", ErrInvalidProjectName
+	}
+	return name, nil
+}
+
+func NormalizeProjectColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color == "" {
+		return "", nil
+	}
+	if !projectColorPattern.MatchString(color) {
+		return "", ErrInvalidProjectColor
+	}
+	return color, nil
+}
+
+type ProjectRepository interface {
+	Create(ctx context.Context, project Project) (Project, error)
+	GetByIDAndMemberID(ctx context.Context, id, userID int64) (Project, error)
+	GetByMemberID(ctx context.Context, userID int64, includeArchived bool) ([]Project, error)
+	UpdateByIDAndUserID(ctx context.Context, id, userID int64, update ProjectUpdate) (Project, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID int64) error
+	CountTasksByProjectIDs(ctx context.Context, ids []int64) (map[int64]ProjectCounts, error)
+	GetMembers(ctx context.Context, projectID int64) ([]ProjectMember, error)
+	SaveMember(ctx context.Context, member ProjectMember) (ProjectMember, error)
+	DeleteMember(ctx context.Context, projectID, userID int64) error
+}
@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	DAILY Frequency = iota + 1
+	WEEKLY
+	MONTHLY
+)
+
+const (
+	rruleUntilLayout     = "20060102T150405Z"
+	rruleUntilDateLayout = "20060102"
+)
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+type Recurrence struct {
+	Freq     Frequency
+	Interval int
+	Weekdays []time.Weekday
+	Until    time.Time
+	Count    int
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var weekdayNames = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+func ParseRRule(rule string) (Recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	rule = strings.TrimPrefix(strings.ToUpper(rule), "RRULE:")
+	if rule == "" {
+		return Recurrence{}, ErrInvalidRecurrence
+	}
+
+	recurrence := Recurrence{Interval: 1}
+	seen := make(map[string]struct{})
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Recurrence{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRecurrence, part)
+		}
+		if _, dup := seen[name]; dup {
+			return Recurrence{}, fmt.Errorf("%w: duplicate %s", ErrInvalidRecurrence, name)
+		}
+		seen[name] = struct{}{}
+
+		switch name {
+		case "FREQ":
+			switch value {
+			case "DAILY":
+				recurrence.Freq = DAILY
+			case "WEEKLY":
+				recurrence.Freq = WEEKLY
+			case "MONTHLY":
+				recurrence.Freq = MONTHLY
+			default:
+				return Recurrence{}, fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRecurrence, value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval <= 0 {
+				return Recurrence{}, fmt.Errorf("%w: invalid INTERVAL %q", ErrInvalidRecurrence, value)
+			}
+			recurrence.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count <= 0 {
+				return Recurrence{}, fmt.Errorf("%w: invalid COUNT %q", ErrInvalidRecurrence, value)
+			}
+			recurrence.Count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return Recurrence{}, fmt.Errorf("%w: invalid UNTIL %q", ErrInvalidRecurrence, value)
+			}
+			recurrence.Until = until
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				weekday, ok := weekdayCodes[code]
+				if !ok {
+					return Recurrence{}, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRecurrence, code)
+				}
+				recurrence.Weekdays = append(recurrence.Weekdays, weekday)
+			}
+		case "WKST":
+			if value != "MO" {
+				return Recurrence{}, fmt.Errorf("%w: unsupported WKST %q", ErrInvalidRecurrence, value)
+			}
+		default:
+			return Recurrence{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRecurrence, name)
+		}
+	}
+
+	if recurrence.Freq == 0 {
+		return Recurrence{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrence)
+	}
+	if recurrence.Count > 0 && !recurrence.Until.IsZero() {
+		return Recurrence{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRecurrence)
+	}
+	if len(recurrence.Weekdays) > 0 && recurrence.Freq != WEEKLY {
+		return Recurrence{}, fmt.Errorf("%w: BYDAY is supported only for WEEKLY", ErrInvalidRecurrence)
+	}
+	recurrence.Weekdays = normalizeWeekdays(recurrence.Weekdays)
+	return recurrence, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(rruleUntilLayout, value); err == nil {
+		return until, nil
+	}
+	until, err := time.Parse(rruleUntilDateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return until.Add(24*time.Hour - time.Second), nil
+}
+
+func (r Recurrence) String() string {
+	parts := make([]string, 0, 5)
+	switch r.Freq {
+	case DAILY:
+		parts = append(parts, "FREQ=DAILY")
+	case WEEKLY:
+		parts = append(parts, "FREQ=WEEKLY")
+	case MONTHLY:
+		parts = append(parts, "FREQ=MONTHLY")
+	}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		codes := make([]string, 0, len(r.Weekdays))
+		for _, weekday := range r.Weekdays {
+			codes = append(codes, weekdayNames[weekday])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleUntilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrence returns the n-th (zero-based) occurrence of a series that starts
+// at start. The second result is false once the rule's COUNT or UNTIL is exhausted.
+func (r Recurrence) Occurrence(start time.Time, n int) (time.Time, bool) {
+	if n < 0 || (r.Count > 0 && n >= r.Count) {
+		return time.Time{}, false
+	}
+
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+
+	var at time.Time
+	switch {
+	case r.Freq == DAILY:
+		at = start.AddDate(0, 0, n*interval)
+	case r.Freq == WEEKLY && len(r.Weekdays) == 0:
+		at = start.AddDate(0, 0, 7*n*interval)
+	case r.Freq == WEEKLY:
+		at = r.weeklyOccurrence(start, n, interval)
+	case r.Freq == MONTHLY:
+		at = addMonthsClamped(start, n*interval)
+	default:
+		return time.Time{}, false
+	}
+
+	if !r.Until.IsZero() && at.After(r.Until) {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// NextAfter returns the first occurrence strictly after the given moment
+// together with its index.
+func (r Recurrence) NextAfter(start, after time.Time, from int) (time.Time, int, bool) {
+	for n := from; ; n++ {
+		at, ok := r.Occurrence(start, n)
+		if !ok {
+			return time.Time{}, 0, false
+		}
+		if at.After(after) {
+			return at, n, true
+		}
+	}
+}
+
+// weeklyOccurrence finds the n-th BYDAY occurrence directly: the days of the
+// start week on or after start come first, then every interval-th week
+// contributes all of its weekdays.
+func (r Recurrence) weeklyOccurrence(start time.Time, n, interval int) time.Time {
+	offset := (int(start.Weekday()) + 6) % 7
+	weekStart := start.AddDate(0, 0, -offset)
+
+	var first []time.Time
+	for _, weekday := range r.Weekdays {
+		day := weekStart.AddDate(0, 0, (int(weekday)+6)%7)
+		if !day.Before(start) {
+			first = append(first, day)
+		}
+	}
+	if n < len(first) {
+		return first[n]
+	}
+
+	n -= len(first)
+	week := (1 + n/len(r.Weekdays)) * interval
+	weekday := r.Weekdays[n%len(r.Weekdays)]
+	return weekStart.AddDate(0, 0, week*7+(int(weekday)+6)%7)
+}
+
+func addMonthsClamped(start time.Time, months int) time.Time {
+	year, month, day := start.Date()
+	first := time.Date(year, month+time.Month(months), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+func normalizeWeekdays(weekdays []time.Weekday) []time.Weekday {
+	if len(weekdays) == 0 {
+		return nil
+	}
+	seen := make(map[time.Weekday]struct{}, len(weekdays))
+	result := make([]time.Weekday, 0, len(weekdays))
+	for _, weekday := range weekdays {
+		if _, ok := seen[weekday]; ok {
+			continue
+		}
+		seen[weekday] = struct{}{}
+		result = append(result, weekday)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return (int(result[i])+6)%7 < (int(result[j])+6)%7
+	})
+	return result
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeeklyOccurrence(t *testing.T) {
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 0, 0, 0, time.UTC)
+	}
+	// A Wednesday.
+	wednesday := at(time.October, 14)
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "days later in the start week come first",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR",
+			start: wednesday,
+			want:  []time.Time{at(time.October, 16), at(time.October, 19), at(time.October, 23), at(time.October, 26)},
+		},
+		{
+			name:  "start on a listed day",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE",
+			start: at(time.October, 12),
+			want:  []time.Time{at(time.October, 12), at(time.October, 14), at(time.October, 19), at(time.October, 21)},
+		},
+		{
+			name:  "no listed day left in the start week",
+			rule:  "FREQ=WEEKLY;BYDAY=MO",
+			start: wednesday,
+			want:  []time.Time{at(time.October, 19), at(time.October, 26)},
+		},
+		{
+			name:  "sunday closes the week",
+			rule:  "FREQ=WEEKLY;BYDAY=SU",
+			start: wednesday,
+			want:  []time.Time{at(time.October, 18), at(time.October, 25)},
+		},
+		{
+			name:  "every other week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start: wednesday,
+			want:  []time.Time{at(time.October, 16), at(time.October, 26), at(time.October, 30), at(time.November, 9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q): %v", tt.rule, err)
+			}
+			for n, want := range tt.want {
+				got, ok := recurrence.Occurrence(tt.start, n)
+				if !ok || !got.Equal(want) {
+					t.Fatalf("occurrence %d = %v, %v, want %v", n, got, ok, want)
+				}
+				if !containsWeekday(recurrence.Weekdays, got.Weekday()) {
+					t.Fatalf("occurrence %d falls on %v, not in BYDAY", n, got.Weekday())
+				}
+			}
+		})
+	}
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 18, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		start  time.Time
+		months int
+		want   time.Time
+	}{
+		{name: "same month", start: date(2026, time.March, 15), months: 0, want: date(2026, time.March, 15)},
+		{name: "short month", start: date(2026, time.January, 31), months: 1, want: date(2026, time.February, 28)},
+		{name: "leap year", start: date(2028, time.January, 31), months: 1, want: date(2028, time.February, 29)},
+		{name: "day restored after a short month", start: date(2026, time.January, 31), months: 2, want: date(2026, time.March, 31)},
+		{name: "thirty day month", start: date(2026, time.October, 31), months: 1, want: date(2026, time.November, 30)},
+		{name: "across the year", start: date(2026, time.December, 31), months: 2, want: date(2027, time.February, 28)},
+		{name: "backwards", start: date(2026, time.March, 31), months: -1, want: date(2026, time.February, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addMonthsClamped(tt.start, tt.months)
+			if !got.Equal(tt.want) {
+				t.Fatalf("addMonthsClamped(%v, %d) = %v, want %v", tt.start, tt.months, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAfter(t *testing.T) {
+	start := time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC)
+	day := func(day int) time.Time {
+		return time.Date(2026, time.October, day, 10, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		rule    string
+		after   time.Time
+		from    int
+		want    time.Time
+		wantN   int
+		wantEnd bool
+	}{
+		{name: "next in line", rule: "FREQ=DAILY", after: day(1), from: 1, want: day(2), wantN: 1},
+		{name: "overdue occurrences are skipped", rule: "FREQ=DAILY", after: day(5).Add(2 * time.Hour), from: 1, want: day(6), wantN: 5},
+		{name: "occurrence due exactly now is skipped", rule: "FREQ=DAILY", after: day(5), from: 1, want: day(6), wantN: 5},
+		{name: "never before from", rule: "FREQ=DAILY", after: day(1), from: 7, want: day(8), wantN: 7},
+		{name: "count exhausted while skipping", rule: "FREQ=DAILY;COUNT=3", after: day(5), from: 1, wantEnd: true},
+		{name: "until passed while skipping", rule: "FREQ=DAILY;UNTIL=20261004", after: day(5), from: 1, wantEnd: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q): %v", tt.rule, err)
+			}
+			got, n, ok := recurrence.NextAfter(start, tt.after, tt.from)
+			if ok == tt.wantEnd {
+				t.Fatalf("NextAfter ok = %v, want %v", ok, !tt.wantEnd)
+			}
+			if !got.Equal(tt.want) || n != tt.wantN {
+				t.Fatalf("NextAfter = %v #%d, want %v #%d", got, n, tt.want, tt.wantN)
+			}
+		})
+	}
+}
+
+func containsWeekday(weekdays []time.Weekday, weekday time.Weekday) bool {
+	for _, w := range weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+	return false
+}
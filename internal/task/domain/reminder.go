@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	MaxReminders      = 5
+	MaxReminderOffset = 30 * 24 * time.Hour
+)
+
+var ErrInvalidReminder = errors.New("invalid reminder")
+
+type Reminder struct {
+	TaskID      int64
+	UserID      int64
+	Description string
+	DueDate     time.Time
+	Offset      time.Duration
+	RemindAt    time.Time
+}
+
+func NormalizeReminders(offsets []time.Duration) ([]time.Duration, error) {
+	if len(offsets) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[time.Duration]struct{}, len(offsets))
+	result := make([]time.Duration, 0, len(offsets))
+	for _, offset := range offsets {
+		if offset <= 0 || offset > MaxReminderOffset || offset%time.Minute != 0 {
+			return nil, ErrInvalidReminder
+		}
+		if _, ok := seen[offset]; ok {
+			continue
+		}
+		seen[offset] = struct{}{}
+		result = append(result, offset)
+	}
+	if len(result) > MaxReminders {
+		return nil, ErrInvalidReminder
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
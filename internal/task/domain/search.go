@@ -0,0 +1,9 @@
+package domain
+
+const MaxSearchQueryLength = 256
+
+type SearchHit struct {
+	Task    Task
+	Rank    float64
+	Snippet string
+}
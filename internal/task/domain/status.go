@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+type StatusChange struct {
+	To         TaskStatus
+	Reopen     bool
+	DueDate    time.Time
+	StartTimer bool
+}
+
+type transitionRule int
+
+const (
+	ruleAllowed transitionRule = iota + 1
+	ruleReopen
+	ruleNewDueDate
+)
+
+var transitions = map[TaskStatus]map[TaskStatus]transitionRule{
+	CREATED: {
+		AT_WORK:   ruleAllowed,
+		COMPLETED: ruleAllowed,
+		EXPIRED:   ruleAllowed,
+	},
+	AT_WORK: {
+		CREATED:   ruleAllowed,
+		COMPLETED: ruleAllowed,
+		EXPIRED:   ruleAllowed,
+	},
+	COMPLETED: {
+		CREATED: ruleReopen,
+		AT_WORK: ruleReopen,
+	},
+	EXPIRED: {
+		AT_WORK: ruleNewDueDate,
+	},
+}
+
+// ValidateTransition checks change against the transition table. A due date
+// is only accepted where the transition requires one, so no other transition
+// can move the due date, let alone into the past.
+func ValidateTransition(from TaskStatus, change StatusChange, now time.Time) error {
+	rule, ok := transitions[from][change.To]
+	if !ok {
+		return ErrInvalidTransition
+	}
+
+	switch rule {
+	case ruleReopen:
+		if !change.Reopen {
+			return ErrInvalidTransition
+		}
+	case ruleNewDueDate:
+		if change.DueDate.IsZero() || !change.DueDate.After(now) {
+			return ErrInvalidTransition
+		}
+		return nil
+	}
+	if !change.DueDate.IsZero() {
+		return ErrInvalidTransition
+	}
+	return nil
+}
+
+func AllowedFrom(to TaskStatus) []TaskStatus {
+	var statuses []TaskStatus
+	for _, from := range []TaskStatus{CREATED, AT_WORK, COMPLETED, EXPIRED} {
+		if transitions[from][to] == ruleAllowed {
+			statuses = append(statuses, from)
+		}
+	}
+	return statuses
+}
+
+func (s TaskStatus) String() string {
+	switch s {
+	case CREATED:
+		return "created"
+	case AT_WORK:
+		return "at_work"
+	case COMPLETED:
+		return "completed"
+	case EXPIRED:
+		return "expired"
+	default:
+		return strconv.Itoa(int(s))
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidateTransition(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	future := now.Add(24 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		from    TaskStatus
+		change  StatusChange
+		wantErr error
+	}{
+		{name: "start work", from: CREATED, change: StatusChange{To: AT_WORK}},
+		{name: "start work with timer", from: CREATED, change: StatusChange{To: AT_WORK, StartTimer: true}},
+		{name: "complete created", from: CREATED, change: StatusChange{To: COMPLETED}},
+		{name: "expire created", from: CREATED, change: StatusChange{To: EXPIRED}},
+		{name: "stop work", from: AT_WORK, change: StatusChange{To: CREATED}},
+		{name: "complete at work", from: AT_WORK, change: StatusChange{To: COMPLETED}},
+		{name: "expire at work", from: AT_WORK, change: StatusChange{To: EXPIRED}},
+		{name: "same status", from: CREATED, change: StatusChange{To: CREATED}, wantErr: ErrInvalidTransition},
+		{name: "unknown status", from: CREATED, change: StatusChange{To: TaskStatus(99)}, wantErr: ErrInvalidTransition},
+		{name: "unknown source status", from: TaskStatus(99), change: StatusChange{To: AT_WORK}, wantErr: ErrInvalidTransition},
+		{name: "due date on a plain transition", from: CREATED, change: StatusChange{To: AT_WORK, DueDate: future}, wantErr: ErrInvalidTransition},
+
+		{name: "reopen completed", from: COMPLETED, change: StatusChange{To: CREATED, Reopen: true}},
+		{name: "reopen completed into work", from: COMPLETED, change: StatusChange{To: AT_WORK, Reopen: true}},
+		{name: "reopen without flag", from: COMPLETED, change: StatusChange{To: CREATED}, wantErr: ErrInvalidTransition},
+		{name: "reopen with due date", from: COMPLETED, change: StatusChange{To: CREATED, Reopen: true, DueDate: future}, wantErr: ErrInvalidTransition},
+		{name: "expire completed", from: COMPLETED, change: StatusChange{To: EXPIRED}, wantErr: ErrInvalidTransition},
+
+		{name: "resume expired with new due date", from: EXPIRED, change: StatusChange{To: AT_WORK, DueDate: future}},
+		{name: "resume expired without due date", from: EXPIRED, change: StatusChange{To: AT_WORK}, wantErr: ErrInvalidTransition},
+		{name: "resume expired with past due date", from: EXPIRED, change: StatusChange{To: AT_WORK, DueDate: past}, wantErr: ErrInvalidTransition},
+		{name: "resume expired with due date now", from: EXPIRED, change: StatusChange{To: AT_WORK, DueDate: now}, wantErr: ErrInvalidTransition},
+		{name: "complete expired", from: EXPIRED, change: StatusChange{To: COMPLETED}, wantErr: ErrInvalidTransition},
+		{name: "recreate expired", from: EXPIRED, change: StatusChange{To: CREATED, DueDate: future}, wantErr: ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransition(tt.from, tt.change, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateTransition(%v -> %v) error = %v, want %v", tt.from, tt.change.To, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAllowedFrom(t *testing.T) {
+	tests := []struct {
+		to   TaskStatus
+		want []TaskStatus
+	}{
+		{to: CREATED, want: []TaskStatus{AT_WORK}},
+		{to: AT_WORK, want: []TaskStatus{CREATED}},
+		{to: COMPLETED, want: []TaskStatus{CREATED, AT_WORK}},
+		{to: EXPIRED, want: []TaskStatus{CREATED, AT_WORK}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.to.String(), func(t *testing.T) {
+			if got := AllowedFrom(tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("AllowedFrom(%v) = %v, want %v", tt.to, got, tt.want)
+			}
+		})
+	}
+}
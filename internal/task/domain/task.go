@@ -7,12 +7,55 @@ import (
 )
 
 type Task struct {
-	ID          int64
-	UserID      int64
-	Description string
-	Status      TaskStatus
-	CreatedAt   time.Time
-	DueDate     time.Time
+	ID           int64
+	UserID       int64
+	Description  string
+	Status       TaskStatus
+	CreatedAt    time.Time
+	DueDate      time.Time
+	Priority     Priority
+	Tags         []string
+	ParentID     int64
+	Position     int
+	Subtasks     []Task
+	Recurrence   string
+	SeriesID     int64
+	Occurrence   int
+	SeriesStart  time.Time
+	Reminders    []time.Duration
+	ProjectID    int64
+	AssigneeID   int64
+	DeletedAt    time.Time
+	Version      int64
+	BlockedBy    []int64
+	OpenBlockers int
+	BoardRank    string
+}
+
+type TaskUpdate struct {
+	Description *string
+	DueDate     *time.Time
+	Priority    *Priority
+	Tags        *[]string
+	Recurrence  *string
+	Reminders   *[]time.Duration
+}
+
+// CheckVersion reports ErrVersionMismatch when an expected version is given
+// and differs from the task's current one.
+func (t Task) CheckVersion(version int64) error {
+	if version != 0 && t.Version != version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+func (u TaskUpdate) IsEmpty() bool {
+	return !u.HasColumns() && u.Tags == nil && u.Reminders == nil
+}
+
+func (u TaskUpdate) HasColumns() bool {
+	return u.Description != nil || u.DueDate != nil || u.Priority != nil || u.Recurrence != nil
 }
 
 type TaskStatus int
@@ -25,17 +68,46 @@ const (
 )
 
 var (
-	ErrNotFound  = errors.New("not found")
-	ErrForbidden = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrForbidden          = errors.New("forbidden")
+	ErrIncompleteSubtasks = errors.New("task has incomplete subtasks")
+	ErrSubtaskMismatch    = errors.New("subtask ids do not match parent subtasks")
+	ErrVersionMismatch    = errors.New("task version mismatch")
 )
 
 type TaskRepository interface {
 	Create(ctx context.Context, task Task) (Task, error)
 	GetByID(ctx context.Context, id int64) (Task, error)
 	GetByIDAndUserID(ctx context.Context, id, userID int64) (Task, error)
+	List(ctx context.Context, filter TaskFilter) ([]Task, error)
+	Search(ctx context.Context, filter TaskFilter, offset int) ([]SearchHit, error)
 	GetByUserIDAndDueDateBetween(ctx context.Context, userID int64, from, to time.Time) ([]Task, error)
 	GetByDueDateBetween(ctx context.Context, from, to time.Time) ([]Task, error)
 	GetByDueDateBetweenAndStatusNot(ctx context.Context, from, to time.Time, status TaskStatus) ([]Task, error)
-	UpdateStatusByIDAndUserID(ctx context.Context, id, userID int64, status TaskStatus) (Task, error)
-	UpdateStatusByIDs(ctx context.Context, ids []int64, status TaskStatus) error
+	UpdateStatusByIDAndUserID(ctx context.Context, id, userID, version int64, from TaskStatus, change StatusChange) (Task, error)
+	UpdateStatusByIDsAndUserID(ctx context.Context, ids []int64, userID int64, from []TaskStatus, to TaskStatus) error
+	UpdateByIDAndUserID(ctx context.Context, id, userID, version int64, update TaskUpdate) (Task, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID, version int64, deletedAt time.Time) error
+	GetDeletedByID(ctx context.Context, id int64) (Task, error)
+	GetDeletedByUserID(ctx context.Context, userID int64) ([]Task, error)
+	RestoreByIDAndUserID(ctx context.Context, id, userID int64) (Task, error)
+	PurgeByIDAndUserID(ctx context.Context, id, userID int64) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
+	GetByParentIDs(ctx context.Context, parentIDs []int64) ([]Task, error)
+	CountIncompleteByParentID(ctx context.Context, parentID int64) (int, error)
+	ReorderByParentID(ctx context.Context, parentID int64, ids []int64) error
+	GetRecurringWithoutNext(ctx context.Context, statuses []TaskStatus, limit int) ([]Task, error)
+	CreateOccurrence(ctx context.Context, task Task) (Task, bool, error)
+	GetDueReminders(ctx context.Context, from, to time.Time, statuses []TaskStatus) ([]Reminder, error)
+	UpdateProjectByIDAndUserID(ctx context.Context, id, userID, version, projectID int64) (Task, error)
+	UpdateAssigneeByIDAndUserID(ctx context.Context, id, userID, version, assigneeID int64) (Task, error)
+	GetEventsByTaskID(ctx context.Context, taskID int64) ([]TaskEvent, error)
+	AddDependency(ctx context.Context, taskID, blockerID int64) error
+	RemoveDependency(ctx context.Context, taskID, blockerID int64) error
+	GetBlockersByTaskID(ctx context.Context, taskID int64) ([]Task, error)
+	GetDependentsByBlockerID(ctx context.Context, blockerID int64) ([]Task, error)
+	GetBoardColumn(ctx context.Context, board Board, status TaskStatus, limit int) ([]Task, int, error)
+	MoveOnBoard(ctx context.Context, id, userID, version int64, from TaskStatus, change StatusChange, afterID int64) (Task, error)
+	GetByUserIDAndDescriptions(ctx context.Context, userID int64, descriptions []string) ([]Task, error)
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
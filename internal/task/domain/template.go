@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	MaxTemplateNameLength = 200
+	// MaxTemplateItems bounds the number of tasks, subtasks included, that a
+	// template creates.
+	MaxTemplateItems = 200
+	// MaxDueOffsetDays bounds how far from the start date a template task
+	// may be due, in either direction.
+	MaxDueOffsetDays = 3660
+)
+
+// TaskTemplate is a named set of task blueprints that can be instantiated
+// again and again.
+type TaskTemplate struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Items     []TemplateItem
+	ItemCount int
+	CreatedAt time.Time
+}
+
+// TemplateItem is the blueprint of one task. Its due date is DueOffsetDays
+// after the start date the template is instantiated with.
+type TemplateItem struct {
+	Description   string
+	DueOffsetDays int
+	Priority      Priority
+	Tags          []string
+	Subtasks      []TemplateItem
+}
+
+// CountItems returns the number of items in the trees of items.
+func CountItems(items []TemplateItem) int {
+	count := len(items)
+	for _, item := range items {
+		count += CountItems(item.Subtasks)
+	}
+	return count
+}
+
+type TaskTemplateRepository interface {
+	Create(ctx context.Context, template TaskTemplate) (TaskTemplate, error)
+	GetByIDAndUserID(ctx context.Context, id, userID int64) (TaskTemplate, error)
+	GetByUserID(ctx context.Context, userID int64) ([]TaskTemplate, error)
+	DeleteByIDAndUserID(ctx context.Context, id, userID int64) error
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	MaxTimeEntryNoteLength = 1000
+	MaxTimeEntryDuration   = 24 * time.Hour
+	MaxTimeReportDays      = 366
+)
+
+// TimeEntry is a stretch of time a user spent on a task. A running timer is
+// an entry without EndedAt; each user has at most one of them.
+type TimeEntry struct {
+	ID        int64
+	TaskID    int64
+	UserID    int64
+	ProjectID int64
+	StartedAt time.Time
+	EndedAt   time.Time
+	Note      string
+	Manual    bool
+	CreatedAt time.Time
+}
+
+func (e TimeEntry) Running() bool {
+	return e.EndedAt.IsZero()
+}
+
+// Duration is the tracked time of the entry; running entries count up to now.
+func (e TimeEntry) Duration(now time.Time) time.Duration {
+	end := e.EndedAt
+	if e.Running() {
+		end = now
+	}
+	if end.Before(e.StartedAt) {
+		return 0
+	}
+	return end.Sub(e.StartedAt)
+}
+
+type DailyTime struct {
+	Day      string
+	Duration time.Duration
+}
+
+// TaskTime is the tracked time of one task with its per-day breakdown.
+type TaskTime struct {
+	Entries []TimeEntry
+	Days    []DailyTime
+	Total   time.Duration
+}
+
+type ProjectDailyTime struct {
+	ProjectID int64
+	Day       string
+	Duration  time.Duration
+}
+
+type ProjectTime struct {
+	ProjectID int64
+	Duration  time.Duration
+}
+
+// TimeReport aggregates tracked time per project and day. Tasks outside any
+// project are reported under ProjectID 0.
+type TimeReport struct {
+	Rows     []ProjectDailyTime
+	Projects []ProjectTime
+	Total    time.Duration
+}
+
+type TimeEntryRepository interface {
+	Start(ctx context.Context, entry TimeEntry) (TimeEntry, error)
+	Create(ctx context.Context, entry TimeEntry) (TimeEntry, error)
+	GetRunningByUserID(ctx context.Context, userID int64) (TimeEntry, error)
+	GetByTaskID(ctx context.Context, taskID int64) ([]TimeEntry, error)
+	GetByUserIDBetween(ctx context.Context, userID, projectID int64, from, to time.Time) ([]TimeEntry, error)
+	StopRunningByTaskIDAndUserID(ctx context.Context, taskID, userID int64, endedAt time.Time) (TimeEntry, error)
+	StopRunningByTaskID(ctx context.Context, taskID int64, endedAt time.Time) (int, error)
+	DeleteByIDAndUserID(ctx context.Context, id, taskID, userID int64) error
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "strings"
+
+type TransferFormat int
+
+const (
+	FORMAT_CSV TransferFormat = iota + 1
+	FORMAT_JSONL
+)
+
+// MaxImportRows bounds the number of data rows in one import.
+const MaxImportRows = 5000
+
+type ImportRowStatus int
+
+const (
+	IMPORT_CREATED ImportRowStatus = iota + 1
+	IMPORT_DUPLICATE
+	IMPORT_INVALID
+)
+
+// ImportRow reports the outcome of one data row, identified by the line it
+// starts on. TaskID is the created task, or the task a duplicate matches. A
+// dry run creates nothing, so there only duplicates of existing tasks have
+// one.
+type ImportRow struct {
+	Line   int
+	Status ImportRowStatus
+	TaskID int64
+	Error  string
+}
+
+type ImportReport struct {
+	DryRun     bool
+	Created    int
+	Duplicates int
+	Invalid    int
+	Rows       []ImportRow
+}
+
+func (r *ImportReport) Add(row ImportRow) {
+	switch row.Status {
+	case IMPORT_CREATED:
+		r.Created++
+	case IMPORT_DUPLICATE:
+		r.Duplicates++
+	case IMPORT_INVALID:
+		r.Invalid++
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+// DuplicateKey identifies the tasks an import treats as the same task: same
+// description up to case, same due time, same project and same parent.
+type DuplicateKey struct {
+	Description string
+	DueDate     int64
+	ProjectID   int64
+	ParentID    int64
+}
+
+func DuplicateKeyOf(task Task) DuplicateKey {
+	return DuplicateKey{
+		Description: strings.ToLower(strings.TrimSpace(task.Description)),
+		DueDate:     task.DueDate.Unix(),
+		ProjectID:   task.ProjectID,
+		ParentID:    task.ParentID,
+	}
+}
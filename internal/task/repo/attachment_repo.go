@@ -0,0 +1,160 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type AttachmentRepository struct {
+	conn *sql.DB
+}
+
+const attachmentColumns = "id, task_id, user_id, file_name, content_type, size_bytes, storage_key, created_at"
+
+func NewAttachmentRepository(conn *sql.DB) AttachmentRepository {
+	return AttachmentRepository{conn: conn}
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	query, args, err := squirrel.Insert("task_attachments").
+		Columns("task_id", "user_id", "file_name", "content_type", "size_bytes", "storage_key", "created_at").
+		Values(
+			attachment.TaskID, attachment.UserID, attachment.FileName, attachment.ContentType,
+			attachment.Size, attachment.StorageKey, attachment.CreatedAt,
+		).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("build insert task attachments query: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if err := r.conn.QueryRowContext(ctx, query, args...).Scan(&attachment.ID); err != nil {
+		return domain.Attachment{}, fmt.Errorf("insert task attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+func (r *AttachmentRepository) GetByIDAndTaskID(ctx context.Context, id, taskID int64) (domain.Attachment, error) {
+	query, args, err := squirrel.Select(attachmentColumns).
+		From("task_attachments").
+		Where(squirrel.Eq{"id": id, "task_id": taskID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("select task attachment: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	attachment, err := scanAttachment(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Attachment{}, domain.ErrNotFound
+		}
+		return domain.Attachment{}, fmt.Errorf("select task attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+func (r *AttachmentRepository) GetByTaskID(ctx context.Context, taskID int64) ([]domain.Attachment, error) {
+	query, args, err := squirrel.Select(attachmentColumns).
+		From("task_attachments").
+		Where(squirrel.Eq{"task_id": taskID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task attachments: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryAttachments(ctx, query, args...)
+}
+
+// GetOrphaned returns attachments whose task has been purged; their blobs
+// still have to be removed from the store.
+func (r *AttachmentRepository) GetOrphaned(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	query, args, err := squirrel.Select(attachmentColumns).
+		From("task_attachments").
+		Where(squirrel.Eq{"task_id": nil}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select orphaned task attachments: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryAttachments(ctx, query, args...)
+}
+
+func (r *AttachmentRepository) DeleteByID(ctx context.Context, id int64) error {
+	query, args, err := squirrel.Delete("task_attachments").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task attachment: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete task attachment: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete task attachment: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *AttachmentRepository) queryAttachments(ctx context.Context, query string, args ...any) ([]domain.Attachment, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select task attachments: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+func scanAttachment(row rowScanner) (domain.Attachment, error) {
+	attachment := domain.Attachment{}
+	var taskID sql.NullInt64
+	err := row.Scan(
+		&attachment.ID,
+		&taskID,
+		&attachment.UserID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	attachment.TaskID = taskID.Int64
+	return attachment, err
+}
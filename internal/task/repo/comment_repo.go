@@ -0,0 +1,164 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type CommentRepository struct {
+	conn *sql.DB
+}
+
+const commentColumns = "id, task_id, user_id, body, created_at, updated_at"
+
+func NewCommentRepository(conn *sql.DB) CommentRepository {
+	return CommentRepository{conn: conn}
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment domain.Comment) (domain.Comment, error) {
+	query, args, err := squirrel.Insert("task_comments").
+		Columns("task_id", "user_id", "body", "created_at").
+		Values(comment.TaskID, comment.UserID, comment.Body, comment.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Comment{}, fmt.Errorf("build insert task comments query: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if err := r.conn.QueryRowContext(ctx, query, args...).Scan(&comment.ID); err != nil {
+		return domain.Comment{}, fmt.Errorf("insert task comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (r *CommentRepository) GetByIDAndTaskID(ctx context.Context, id, taskID int64) (domain.Comment, error) {
+	query, args, err := squirrel.Select(commentColumns).
+		From("task_comments").
+		Where(squirrel.Eq{"id": id, "task_id": taskID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Comment{}, fmt.Errorf("select task comment: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	comment, err := scanComment(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Comment{}, domain.ErrNotFound
+		}
+		return domain.Comment{}, fmt.Errorf("select task comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (r *CommentRepository) GetByTaskID(ctx context.Context, taskID, afterID int64, limit int) ([]domain.Comment, error) {
+	builder := squirrel.Select(commentColumns).
+		From("task_comments").
+		Where(squirrel.Eq{"task_id": taskID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+	if afterID > 0 {
+		builder = builder.Where(squirrel.Gt{"id": afterID})
+	}
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task comments: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select task comments: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task comments: %w", err)
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) UpdateByIDAndUserID(ctx context.Context, id, userID int64, body string, updatedAt time.Time) (domain.Comment, error) {
+	query, args, err := squirrel.Update("task_comments").
+		Set("body", body).
+		Set("updated_at", updatedAt).
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix("RETURNING " + commentColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Comment{}, fmt.Errorf("update task comment: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	comment, err := scanComment(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Comment{}, domain.ErrNotFound
+		}
+		return domain.Comment{}, fmt.Errorf("update task comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (r *CommentRepository) DeleteByIDAndUserID(ctx context.Context, id, userID int64) error {
+	query, args, err := squirrel.Delete("task_comments").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task comment: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete task comment: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete task comment: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func scanComment(row rowScanner) (domain.Comment, error) {
+	comment := domain.Comment{}
+	var updatedAt sql.NullTime
+	err := row.Scan(
+		&comment.ID,
+		&comment.TaskID,
+		&comment.UserID,
+		&comment.Body,
+		&comment.CreatedAt,
+		&updatedAt,
+	)
+	comment.UpdatedAt = updatedAt.Time
+	return comment, err
+}
@@ -0,0 +1,150 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type IdempotencyRepository struct {
+	conn *sql.DB
+}
+
+func NewIdempotencyRepository(conn *sql.DB) IdempotencyRepository {
+	return IdempotencyRepository{conn: conn}
+}
+
+// Reserve claims the key for a new request. An expired record under the same
+// key, a stored response or the lease of a request that never completed, is
+// taken over; a live one makes Reserve report false.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record domain.IdempotencyRecord) (bool, error) {
+	query, args, err := squirrel.Insert("idempotency_keys").
+		Columns("user_id", "key", "method", "request_hash", "created_at", "expires_at").
+		Values(record.UserID, record.Key, record.Method, record.RequestHash, record.CreatedAt, record.ExpiresAt).
+		Suffix("ON CONFLICT (user_id, key) DO UPDATE SET method = EXCLUDED.method, request_hash = EXCLUDED.request_hash, " +
+			"response = NULL, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at " +
+			"WHERE idempotency_keys.expires_at <= EXCLUDED.created_at RETURNING user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var userID int64
+	if err := r.conn.QueryRowContext(ctx, query, args...).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	return true, nil
+}
+
+func (r *IdempotencyRepository) GetByUserIDAndKey(ctx context.Context, userID int64, key string) (domain.IdempotencyRecord, error) {
+	query, args, err := squirrel.Select("user_id", "key", "method", "request_hash", "response", "created_at", "expires_at").
+		From("idempotency_keys").
+		Where(squirrel.Eq{"user_id": userID, "key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.IdempotencyRecord{}, fmt.Errorf("select idempotency key: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	record := domain.IdempotencyRecord{}
+	err = r.conn.QueryRowContext(ctx, query, args...).Scan(
+		&record.UserID,
+		&record.Key,
+		&record.Method,
+		&record.RequestHash,
+		&record.Response,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.IdempotencyRecord{}, domain.ErrNotFound
+		}
+		return domain.IdempotencyRecord{}, fmt.Errorf("select idempotency key: %w", err)
+	}
+	return record, nil
+}
+
+// SaveResponse stores the response of a completed request and keeps it until
+// expiresAt. It does nothing once the reservation has been taken over by
+// another request.
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, reservation domain.IdempotencyRecord, response []byte, expiresAt time.Time) error {
+	query, args, err := squirrel.Update("idempotency_keys").
+		Set("response", response).
+		Set("expires_at", expiresAt).
+		Where(reservationOf(reservation)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("save idempotency response: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := r.conn.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("save idempotency response: %w", err)
+	}
+	return nil
+}
+
+// DeleteReservation frees the key of a request that failed, unless the
+// reservation has been taken over by another request.
+func (r *IdempotencyRepository) DeleteReservation(ctx context.Context, reservation domain.IdempotencyRecord) error {
+	query, args, err := squirrel.Delete("idempotency_keys").
+		Where(reservationOf(reservation)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete idempotency key: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := r.conn.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// reservationOf matches the record while it still belongs to the request
+// that reserved it.
+func reservationOf(reservation domain.IdempotencyRecord) squirrel.Eq {
+	return squirrel.Eq{
+		"user_id":      reservation.UserID,
+		"key":          reservation.Key,
+		"request_hash": reservation.RequestHash,
+		"created_at":   reservation.CreatedAt,
+	}
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query, args, err := squirrel.Delete("idempotency_keys").
+		Where(squirrel.LtOrEq{"expires_at": now}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,364 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type ProjectRepository struct {
+	conn *sql.DB
+}
+
+const projectColumns = "id, user_id, name, color, archived, created_at"
+
+const memberColumns = "project_id, user_id, role, created_at"
+
+func NewProjectRepository(conn *sql.DB) ProjectRepository {
+	return ProjectRepository{conn: conn}
+}
+
+func (r *ProjectRepository) Create(ctx context.Context, project domain.Project) (domain.Project, error) {
+	query, args, err := squirrel.Insert("projects").
+		Columns("user_id", "name", "color", "archived", "created_at").
+		Values(project.UserID, project.Name, project.Color, project.Archived, project.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("build insert projects query: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	err = inTx(ctx, r.conn, func(q querier) error {
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&project.ID); err != nil {
+			return fmt.Errorf("insert project: %w", err)
+		}
+		owner := domain.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    project.UserID,
+			Role:      domain.OWNER,
+			CreatedAt: project.CreatedAt,
+		}
+		_, err := saveMember(ctx, q, owner)
+		return err
+	})
+	if err != nil {
+		return domain.Project{}, err
+	}
+	project.Role = domain.OWNER
+	return project, nil
+}
+
+func (r *ProjectRepository) GetByIDAndMemberID(ctx context.Context, id, userID int64) (domain.Project, error) {
+	query, args, err := selectMemberProjects(userID).
+		Where(squirrel.Eq{"p.id": id}).
+		ToSql()
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("select project: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	project, err := scanProject(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Project{}, domain.ErrNotFound
+		}
+		return domain.Project{}, fmt.Errorf("select project: %w", err)
+	}
+	return project, nil
+}
+
+func (r *ProjectRepository) GetByMemberID(ctx context.Context, userID int64, includeArchived bool) ([]domain.Project, error) {
+	builder := selectMemberProjects(userID).
+		OrderBy("p.name", "p.id")
+	if !includeArchived {
+		builder = builder.Where(squirrel.Eq{"p.archived": false})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select projects: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projects []domain.Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select projects: %w", err)
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select projects: %w", err)
+	}
+	return projects, nil
+}
+
+func (r *ProjectRepository) UpdateByIDAndUserID(ctx context.Context, id, userID int64, update domain.ProjectUpdate) (domain.Project, error) {
+	builder := squirrel.Update("projects").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix(fmt.Sprintf("RETURNING %s, %d", projectColumns, domain.OWNER)).
+		PlaceholderFormat(squirrel.Dollar)
+	if update.Name != nil {
+		builder = builder.Set("name", *update.Name)
+	}
+	if update.Color != nil {
+		builder = builder.Set("color", *update.Color)
+	}
+	if update.Archived != nil {
+		builder = builder.Set("archived", *update.Archived)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("update project: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	project, err := scanProject(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Project{}, domain.ErrNotFound
+		}
+		return domain.Project{}, fmt.Errorf("update project: %w", err)
+	}
+	return project, nil
+}
+
+func (r *ProjectRepository) DeleteByIDAndUserID(ctx context.Context, id, userID int64) error {
+	query, args, err := squirrel.Delete("projects").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete project: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	detachQuery, detachArgs, err := squirrel.Update("tasks").
+		Set("project_id", nil).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"project_id": id}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("detach project tasks: %w", err)
+	}
+
+	return inTx(ctx, r.conn, func(q querier) error {
+		logger.Log.Infof("sql: %s", detachQuery)
+		ids, err := queryIDs(ctx, q, detachQuery, detachArgs...)
+		if err != nil {
+			return fmt.Errorf("detach project tasks: %w", err)
+		}
+
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("delete project: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("delete project: %w", err)
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+
+		events := make([]domain.TaskEvent, 0, len(ids))
+		for _, taskID := range ids {
+			events = append(events, domain.FieldChanged(taskID, "project_id", id, 0))
+		}
+		return recordEvents(ctx, q, events)
+	})
+}
+
+func (r *ProjectRepository) CountTasksByProjectIDs(ctx context.Context, ids []int64) (map[int64]domain.ProjectCounts, error) {
+	counts := make(map[int64]domain.ProjectCounts)
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	query, args, err := squirrel.Select("project_id", "status", "COUNT(*)").
+		From("tasks").
+		Where(squirrel.Eq{"project_id": ids, "parent_id": nil, "deleted_at": nil}).
+		GroupBy("project_id", "status").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("count project tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("count project tasks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			projectID int64
+			status    domain.TaskStatus
+			count     int
+		)
+		if err := rows.Scan(&projectID, &status, &count); err != nil {
+			return nil, fmt.Errorf("count project tasks: %w", err)
+		}
+		projectCounts := counts[projectID]
+		projectCounts.Add(status, count)
+		counts[projectID] = projectCounts
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("count project tasks: %w", err)
+	}
+	return counts, nil
+}
+
+func (r *ProjectRepository) GetMembers(ctx context.Context, projectID int64) ([]domain.ProjectMember, error) {
+	query, args, err := squirrel.Select(memberColumns).
+		From("project_members").
+		Where(squirrel.Eq{"project_id": projectID}).
+		OrderBy("role", "created_at", "user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select project members: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select project members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []domain.ProjectMember
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select project members: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select project members: %w", err)
+	}
+	return members, nil
+}
+
+func (r *ProjectRepository) SaveMember(ctx context.Context, member domain.ProjectMember) (domain.ProjectMember, error) {
+	return saveMember(ctx, r.conn, member)
+}
+
+func (r *ProjectRepository) DeleteMember(ctx context.Context, projectID, userID int64) error {
+	query, args, err := squirrel.Delete("project_members").
+		Where(squirrel.Eq{"project_id": projectID, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete project member: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	unassignQuery, unassignArgs, err := squirrel.Update("tasks").
+		Set("assignee_id", nil).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"project_id": projectID, "assignee_id": userID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unassign member tasks: %w", err)
+	}
+
+	return inTx(ctx, r.conn, func(q querier) error {
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("delete project member: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("delete project member: %w", err)
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+
+		logger.Log.Infof("sql: %s", unassignQuery)
+		ids, err := queryIDs(ctx, q, unassignQuery, unassignArgs...)
+		if err != nil {
+			return fmt.Errorf("unassign member tasks: %w", err)
+		}
+		events := make([]domain.TaskEvent, 0, len(ids))
+		for _, id := range ids {
+			events = append(events, domain.FieldChanged(id, "assignee_id", userID, 0))
+		}
+		return recordEvents(ctx, q, events)
+	})
+}
+
+func saveMember(ctx context.Context, q querier, member domain.ProjectMember) (domain.ProjectMember, error) {
+	query, args, err := squirrel.Insert("project_members").
+		Columns("project_id", "user_id", "role", "created_at").
+		Values(member.ProjectID, member.UserID, member.Role, member.CreatedAt).
+		Suffix("ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role RETURNING " + memberColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("save project member: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	saved, err := scanMember(q.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("save project member: %w", err)
+	}
+	return saved, nil
+}
+
+func selectMemberProjects(userID int64) squirrel.SelectBuilder {
+	return squirrel.Select("p.id", "p.user_id", "p.name", "p.color", "p.archived", "p.created_at", "m.role").
+		From("projects AS p").
+		Join("project_members AS m ON m.project_id = p.id AND m.user_id = ?", userID).
+		PlaceholderFormat(squirrel.Dollar)
+}
+
+func scanMember(row rowScanner) (domain.ProjectMember, error) {
+	member := domain.ProjectMember{}
+	err := row.Scan(
+		&member.ProjectID,
+		&member.UserID,
+		&member.Role,
+		&member.CreatedAt,
+	)
+	return member, err
+}
+
+func scanProject(row rowScanner) (domain.Project, error) {
+	project := domain.Project{}
+	err := row.Scan(
+		&project.ID,
+		&project.UserID,
+		&project.Name,
+		&project.Color,
+		&project.Archived,
+		&project.CreatedAt,
+		&project.Role,
+	)
+	return project, err
+}
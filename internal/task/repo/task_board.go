@@ -0,0 +1,322 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// GetBoardColumn returns up to limit top-level tasks of one board column in
+// board order, together with the number of tasks in the column. Ranked tasks
+// come first; tasks that were never placed follow in creation order.
+func (r *TaskRepository) GetBoardColumn(ctx context.Context, board domain.Board, status domain.TaskStatus, limit int) ([]domain.Task, int, error) {
+	column := squirrel.And{boardTasks(board), squirrel.Eq{"status": status}}
+
+	countQuery, countArgs, err := squirrel.Select("COUNT(*)").
+		From("tasks").
+		Where(column).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("count board tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", countQuery)
+
+	var total int
+	if err := r.reader(ctx).QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count board tasks: %w", err)
+	}
+
+	builder := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(column).
+		OrderBy("board_rank", "id").
+		PlaceholderFormat(squirrel.Dollar)
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("select board tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	tasks, err := r.queryTasks(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tasks, total, nil
+}
+
+// MoveOnBoard puts a task right below afterID (or on top of the column when
+// afterID is 0) in the column of change.To, changing its status in the same
+// statement when the column differs. Only the moved task is written, unless
+// the neighbour has never been placed or the new rank grows too long; then
+// the column is ranked first.
+func (r *TaskRepository) MoveOnBoard(ctx context.Context, id, userID, version int64, from domain.TaskStatus, change domain.StatusChange, afterID int64) (domain.Task, error) {
+	var task domain.Task
+	err := r.withTx(ctx, func(q querier) error {
+		before, err := r.lockTask(ctx, q, id, userID, version)
+		if err != nil {
+			return err
+		}
+		if before.Status != from {
+			return domain.ErrInvalidTransition
+		}
+		board := domain.BoardOf(before)
+		if err := lockBoard(ctx, q, board); err != nil {
+			return err
+		}
+
+		column := squirrel.And{boardTasks(board), squirrel.Eq{"status": change.To}, squirrel.NotEq{"id": id}}
+		rank, err := r.rankBelow(ctx, q, column, afterID)
+		if err != nil {
+			return err
+		}
+
+		builder := squirrel.Update("tasks").
+			Set("board_rank", rank).
+			Set("version", nextVersion).
+			Where(squirrel.Eq{"id": id, "user_id": userID, "status": from}).
+			Suffix("RETURNING " + taskColumns).
+			PlaceholderFormat(squirrel.Dollar)
+		if change.To != from {
+			builder = builder.Set("status", change.To)
+			if !change.DueDate.IsZero() {
+				builder = builder.Set("due_date", change.DueDate)
+			}
+			if change.To == domain.COMPLETED {
+				builder = builder.Where(noIncompleteSubtasks())
+			}
+			if change.To == domain.AT_WORK {
+				builder = builder.Where(noOpenBlockers())
+			}
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("move task on board: %w", err)
+		}
+		logger.Log.Infof("sql: %s", query)
+
+		task, err = scanTask(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrInvalidTransition
+			}
+			return fmt.Errorf("move task on board: %w", err)
+		}
+		task, err = r.withDetails(ctx, q, task)
+		if err != nil {
+			return err
+		}
+		return recordChanges(ctx, q, []domain.Task{before}, []domain.Task{task})
+	})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+// rankBelow returns a free rank right below afterID in column.
+func (r *TaskRepository) rankBelow(ctx context.Context, q querier, column squirrel.Sqlizer, afterID int64) (string, error) {
+	prev := ""
+	if afterID != 0 {
+		ranked, rank, err := boardRankOf(ctx, q, column, afterID)
+		if err != nil {
+			return "", err
+		}
+		if !ranked {
+			if err := r.rankColumn(ctx, q, column); err != nil {
+				return "", err
+			}
+			if _, rank, err = boardRankOf(ctx, q, column, afterID); err != nil {
+				return "", err
+			}
+		}
+		prev = rank
+	}
+
+	query, args, err := squirrel.Select("board_rank").
+		From("tasks").
+		Where(column).
+		Where(squirrel.Gt{"board_rank": prev}).
+		OrderBy("board_rank").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("select next board rank: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	next := ""
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&next); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("select next board rank: %w", err)
+	}
+
+	rank, err := domain.RankBetween(prev, next)
+	if err != nil {
+		return "", fmt.Errorf("rank between %q and %q: %w", prev, next, err)
+	}
+	if len(rank) <= domain.MaxRankLength {
+		return rank, nil
+	}
+
+	logger.Log.Infof("board rank too long: re-ranking column length=%d", len(rank))
+	return r.rerankColumn(ctx, q, column, afterID)
+}
+
+// rerankColumn spreads fresh ranks over the whole column, leaving a gap right
+// below afterID, and returns the rank for that gap.
+func (r *TaskRepository) rerankColumn(ctx context.Context, q querier, column squirrel.Sqlizer, afterID int64) (string, error) {
+	ids, err := columnIDs(ctx, q, column)
+	if err != nil {
+		return "", err
+	}
+	slot := 0
+	for i, id := range ids {
+		if id == afterID {
+			slot = i + 1
+		}
+	}
+
+	ranks, err := domain.RanksAfter("", len(ids)+1)
+	if err != nil {
+		return "", err
+	}
+	for i, id := range ids {
+		rank := ranks[i]
+		if i >= slot {
+			rank = ranks[i+1]
+		}
+		if err := setBoardRank(ctx, q, id, rank); err != nil {
+			return "", err
+		}
+	}
+	return ranks[slot], nil
+}
+
+// rankColumn gives the tasks of column that were never placed ranks after
+// the ranked ones, keeping their creation order.
+func (r *TaskRepository) rankColumn(ctx context.Context, q querier, column squirrel.Sqlizer) error {
+	maxQuery, maxArgs, err := squirrel.Select("MAX(board_rank)").
+		From("tasks").
+		Where(column).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("select max board rank: %w", err)
+	}
+	logger.Log.Infof("sql: %s", maxQuery)
+
+	var last sql.NullString
+	if err := q.QueryRowContext(ctx, maxQuery, maxArgs...).Scan(&last); err != nil {
+		return fmt.Errorf("select max board rank: %w", err)
+	}
+
+	ids, err := columnIDs(ctx, q, squirrel.And{column, squirrel.Eq{"board_rank": nil}})
+	if err != nil {
+		return err
+	}
+	ranks, err := domain.RanksAfter(last.String, len(ids))
+	if err != nil {
+		return fmt.Errorf("rank after %q: %w", last.String, err)
+	}
+	for i, id := range ids {
+		if err := setBoardRank(ctx, q, id, ranks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func columnIDs(ctx context.Context, q querier, column squirrel.Sqlizer) ([]int64, error) {
+	query, args, err := squirrel.Select("id").
+		From("tasks").
+		Where(column).
+		OrderBy("board_rank", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select board tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	ids, err := queryIDs(ctx, q, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select board tasks: %w", err)
+	}
+	return ids, nil
+}
+
+// setBoardRank stores a rank without bumping the task version: it only
+// materializes the position the task already had on the board.
+func setBoardRank(ctx context.Context, q querier, id int64, rank string) error {
+	query, args, err := squirrel.Update("tasks").
+		Set("board_rank", rank).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("update board rank: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("update board rank: %w", err)
+	}
+	return nil
+}
+
+func boardRankOf(ctx context.Context, q querier, column squirrel.Sqlizer, id int64) (bool, string, error) {
+	query, args, err := squirrel.Select("board_rank").
+		From("tasks").
+		Where(column).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, "", fmt.Errorf("select board rank: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var rank sql.NullString
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&rank); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, "", domain.ErrInvalidPosition
+		}
+		return false, "", fmt.Errorf("select board rank: %w", err)
+	}
+	return rank.Valid, rank.String, nil
+}
+
+// lockBoard serializes rank changes on one board so that two moves cannot
+// pick the same rank.
+func lockBoard(ctx context.Context, q querier, board domain.Board) error {
+	key := fmt.Sprintf("task_board:user:%d", board.UserID)
+	if board.ProjectID != 0 {
+		key = fmt.Sprintf("task_board:project:%d", board.ProjectID)
+	}
+
+	query := "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))"
+	logger.Log.Infof("sql: %s", query)
+	if _, err := q.ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("lock board: %w", err)
+	}
+	return nil
+}
+
+func boardTasks(board domain.Board) squirrel.Sqlizer {
+	if board.ProjectID != 0 {
+		return squirrel.Eq{"project_id": board.ProjectID, "parent_id": nil, "deleted_at": nil}
+	}
+	return squirrel.Eq{"user_id": board.UserID, "project_id": nil, "parent_id": nil, "deleted_at": nil}
+}
@@ -0,0 +1,194 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// blockersReachable walks the blocked-by edges starting at the blockers of the
+// first argument and reports whether the second argument is among them.
+const blockersReachable = `WITH RECURSIVE reachable (id) AS (
+	SELECT blocker_id FROM task_dependencies WHERE task_id = $1
+	UNION
+	SELECT d.blocker_id FROM task_dependencies AS d JOIN reachable AS r ON d.task_id = r.id
+)
+SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $2)`
+
+// AddDependency marks taskID as blocked by blockerID. Writers are serialized
+// with an advisory lock so that two concurrent links cannot close a cycle
+// that neither of them sees on its own.
+func (r *TaskRepository) AddDependency(ctx context.Context, taskID, blockerID int64) error {
+	query, args, err := squirrel.Insert("task_dependencies").
+		Columns("task_id", "blocker_id").
+		Values(taskID, blockerID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("insert task dependency: %w", err)
+	}
+
+	return r.withTx(ctx, func(q querier) error {
+		lockQuery := "SELECT pg_advisory_xact_lock(hashtext('task_dependencies'))"
+		logger.Log.Infof("sql: %s", lockQuery)
+		if _, err := q.ExecContext(ctx, lockQuery); err != nil {
+			return fmt.Errorf("lock task dependencies: %w", err)
+		}
+
+		logger.Log.Infof("sql: %s", blockersReachable)
+		var cycle bool
+		if err := q.QueryRowContext(ctx, blockersReachable, blockerID, taskID).Scan(&cycle); err != nil {
+			return fmt.Errorf("check task dependency cycle: %w", err)
+		}
+		if cycle {
+			return domain.ErrDependencyCycle
+		}
+
+		logger.Log.Infof("sql: %s", query)
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("insert task dependency: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("insert task dependency: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{domain.FieldChanged(taskID, "blocked_by", 0, blockerID)})
+	})
+}
+
+func (r *TaskRepository) RemoveDependency(ctx context.Context, taskID, blockerID int64) error {
+	query, args, err := squirrel.Delete("task_dependencies").
+		Where(squirrel.Eq{"task_id": taskID, "blocker_id": blockerID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task dependency: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.withTx(ctx, func(q querier) error {
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("delete task dependency: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("delete task dependency: %w", err)
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{domain.FieldChanged(taskID, "blocked_by", blockerID, 0)})
+	})
+}
+
+func (r *TaskRepository) GetBlockersByTaskID(ctx context.Context, taskID int64) ([]domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Expr("id IN (?)", squirrel.Select("blocker_id").
+			From("task_dependencies").
+			Where(squirrel.Eq{"task_id": taskID}))).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task blockers: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) GetDependentsByBlockerID(ctx context.Context, blockerID int64) ([]domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Expr("id IN (?)", squirrel.Select("task_id").
+			From("task_dependencies").
+			Where(squirrel.Eq{"blocker_id": blockerID}))).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task dependents: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
+
+// loadBlockers fills BlockedBy with the live blockers of each task and
+// OpenBlockers with how many of them are not completed yet.
+func (r *TaskRepository) loadBlockers(ctx context.Context, q querier, tasks []domain.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+
+	query, args, err := squirrel.Select("d.task_id", "d.blocker_id", "b.status").
+		From("task_dependencies AS d").
+		Join("tasks AS b ON b.id = d.blocker_id").
+		Where(squirrel.Eq{"d.task_id": ids, "b.deleted_at": nil}).
+		OrderBy("d.task_id", "d.blocker_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("select task dependencies: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("select task dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	blockedBy := make(map[int64][]int64, len(tasks))
+	open := make(map[int64]int, len(tasks))
+	for rows.Next() {
+		var (
+			taskID    int64
+			blockerID int64
+			status    domain.TaskStatus
+		)
+		if err := rows.Scan(&taskID, &blockerID, &status); err != nil {
+			return fmt.Errorf("select task dependencies: %w", err)
+		}
+		blockedBy[taskID] = append(blockedBy[taskID], blockerID)
+		if status != domain.COMPLETED {
+			open[taskID]++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("select task dependencies: %w", err)
+	}
+
+	for i := range tasks {
+		tasks[i].BlockedBy = blockedBy[tasks[i].ID]
+		tasks[i].OpenBlockers = open[tasks[i].ID]
+	}
+	return nil
+}
+
+func noOpenBlockers() squirrel.Sqlizer {
+	return squirrel.Expr("NOT EXISTS (?)", squirrel.Select("1").
+		From("task_dependencies AS d").
+		Join("tasks AS blockers ON blockers.id = d.blocker_id").
+		Where("d.task_id = tasks.id").
+		Where(squirrel.Eq{"blockers.deleted_at": nil}).
+		Where(squirrel.NotEq{"blockers.status": domain.COMPLETED}))
+}
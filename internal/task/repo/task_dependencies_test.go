@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// TestBlockersReachable runs the cycle check of AddDependency against a
+// Postgres database named by TASK_TEST_DB_DSN. The edges live in a temporary
+// table that shadows task_dependencies for the test connection only.
+func TestBlockersReachable(t *testing.T) {
+	dsn := os.Getenv("TASK_TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TASK_TEST_DB_DSN is not set")
+	}
+	ctx := context.Background()
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	// Each edge is {task_id, blocker_id}: the task is blocked by the blocker.
+	tests := []struct {
+		name      string
+		edges     [][2]int64
+		taskID    int64
+		blockerID int64
+		wantCycle bool
+	}{
+		{name: "no dependencies", taskID: 1, blockerID: 2},
+		{name: "unrelated chain", edges: [][2]int64{{2, 3}, {3, 4}}, taskID: 1, blockerID: 2},
+		{name: "direct cycle", edges: [][2]int64{{2, 1}}, taskID: 1, blockerID: 2, wantCycle: true},
+		{name: "long cycle", edges: [][2]int64{{2, 3}, {3, 4}, {4, 1}}, taskID: 1, blockerID: 2, wantCycle: true},
+		{name: "same direction is not a cycle", edges: [][2]int64{{1, 2}}, taskID: 1, blockerID: 2},
+		{name: "shared blocker is not a cycle", edges: [][2]int64{{1, 3}, {2, 3}}, taskID: 1, blockerID: 2},
+		{name: "diamond below the blocker", edges: [][2]int64{{2, 3}, {2, 4}, {3, 5}, {4, 5}, {5, 1}}, taskID: 1, blockerID: 2, wantCycle: true},
+		{name: "existing cycle elsewhere terminates", edges: [][2]int64{{2, 3}, {3, 4}, {4, 3}}, taskID: 1, blockerID: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := db.Conn(ctx)
+			if err != nil {
+				t.Fatalf("conn: %v", err)
+			}
+			defer conn.Close()
+			if _, err := conn.ExecContext(ctx, "CREATE TEMPORARY TABLE task_dependencies (task_id BIGINT NOT NULL, blocker_id BIGINT NOT NULL, PRIMARY KEY (task_id, blocker_id))"); err != nil {
+				t.Fatalf("create table: %v", err)
+			}
+			defer conn.ExecContext(ctx, "DROP TABLE pg_temp.task_dependencies")
+			for _, edge := range tt.edges {
+				if _, err := conn.ExecContext(ctx, "INSERT INTO task_dependencies (task_id, blocker_id) VALUES ($1, $2)", edge[0], edge[1]); err != nil {
+					t.Fatalf("insert edge %v: %v", edge, err)
+				}
+			}
+
+			var cycle bool
+			if err := conn.QueryRowContext(ctx, blockersReachable, tt.blockerID, tt.taskID).Scan(&cycle); err != nil {
+				t.Fatalf("blockersReachable: %v", err)
+			}
+			if cycle != tt.wantCycle {
+				t.Fatalf("linking %d to blocker %d: cycle = %v, want %v", tt.taskID, tt.blockerID, cycle, tt.wantCycle)
+			}
+		})
+	}
+}
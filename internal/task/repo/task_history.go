@@ -0,0 +1,175 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const eventColumns = "id, task_id, type, field, old_value, new_value, actor_id, source, created_at"
+
+func (r *TaskRepository) GetEventsByTaskID(ctx context.Context, taskID int64) ([]domain.TaskEvent, error) {
+	query, args, err := squirrel.Select(eventColumns).
+		From("task_events").
+		Where(squirrel.Eq{"task_id": taskID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task events: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.TaskEvent
+	for rows.Next() {
+		var (
+			event   domain.TaskEvent
+			actorID sql.NullInt64
+		)
+		err := rows.Scan(
+			&event.ID,
+			&event.TaskID,
+			&event.Type,
+			&event.Field,
+			&event.OldValue,
+			&event.NewValue,
+			&actorID,
+			&event.Source,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("select task events: %w", err)
+		}
+		event.ActorID = actorID.Int64
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task events: %w", err)
+	}
+	return events, nil
+}
+
+func recordEvents(ctx context.Context, q querier, events []domain.TaskEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	actor := domain.ActorFromContext(ctx)
+	builder := squirrel.Insert("task_events").
+		Columns("task_id", "type", "field", "old_value", "new_value", "actor_id", "source").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, event := range events {
+		builder = builder.Values(event.TaskID, event.Type, event.Field, event.OldValue, event.NewValue, nullableID(actor.UserID), actor.Source)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert task events: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert task events: %w", err)
+	}
+	return nil
+}
+
+func recordChanges(ctx context.Context, q querier, before, after []domain.Task) error {
+	previous := make(map[int64]domain.Task, len(before))
+	for _, task := range before {
+		previous[task.ID] = task
+	}
+
+	var events []domain.TaskEvent
+	for _, task := range after {
+		if old, ok := previous[task.ID]; ok {
+			events = append(events, domain.DiffTask(old, task)...)
+		}
+	}
+	return recordEvents(ctx, q, events)
+}
+
+func (r *TaskRepository) lockTasks(ctx context.Context, q querier, where squirrel.Sqlizer) ([]domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(where).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("lock tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("lock tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("lock tasks: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("lock tasks: %w", err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("lock tasks: %w", err)
+	}
+	if err := r.loadDetails(ctx, q, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// lockTask locks a live task for the rest of the transaction and checks the
+// expected version against the locked row.
+func (r *TaskRepository) lockTask(ctx context.Context, q querier, id, userID, version int64) (domain.Task, error) {
+	tasks, err := r.lockTasks(ctx, q, squirrel.Eq{"id": id, "user_id": userID})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if len(tasks) == 0 {
+		return domain.Task{}, domain.ErrNotFound
+	}
+	if err := tasks[0].CheckVersion(version); err != nil {
+		return domain.Task{}, err
+	}
+	return tasks[0], nil
+}
+
+func queryIDs(ctx context.Context, q querier, query string, args ...any) ([]int64, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// GetByUserIDAndDescriptions returns the tasks of userID, subtasks included,
+// whose trimmed description matches one of descriptions regardless of case.
+// The descriptions are expected in lower case.
+func (r *TaskRepository) GetByUserIDAndDescriptions(ctx context.Context, userID int64, descriptions []string) ([]domain.Task, error) {
+	if len(descriptions) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"user_id": userID, "deleted_at": nil}).
+		Where(squirrel.Eq{"LOWER(BTRIM(description))": descriptions}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
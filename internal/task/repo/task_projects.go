@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (r *TaskRepository) UpdateProjectByIDAndUserID(ctx context.Context, id, userID, version, projectID int64) (domain.Task, error) {
+	keepAssignee := squirrel.Expr("CASE WHEN assignee_id IN (SELECT user_id FROM project_members WHERE project_id = ?) THEN assignee_id END", projectID)
+
+	query, args, err := squirrel.Update("tasks").
+		Set("project_id", nullableID(projectID)).
+		Set("assignee_id", keepAssignee).
+		Set("board_rank", nil).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix("RETURNING " + taskColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("update task project: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	// Subtasks nest, so the whole tree below the task follows it, trashed
+	// subtasks included so that they come back into the right project.
+	subtasks := inSubtree(squirrel.Eq{"parent_id": id}, "TRUE")
+	subtasksQuery, subtasksArgs, err := squirrel.Update("tasks").
+		Set("project_id", nullableID(projectID)).
+		Set("assignee_id", keepAssignee).
+		Set("version", nextVersion).
+		Where(subtasks).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("update subtasks project: %w", err)
+	}
+
+	affected := squirrel.Or{squirrel.Eq{"id": id, "user_id": userID}, subtasks}
+
+	var task domain.Task
+	err = r.withTx(ctx, func(q querier) error {
+		if _, err := r.lockTask(ctx, q, id, userID, version); err != nil {
+			return err
+		}
+		before, err := r.lockTasks(ctx, q, affected)
+		if err != nil {
+			return err
+		}
+
+		task, err = scanTask(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("update task project: %w", err)
+		}
+
+		logger.Log.Infof("sql: %s", subtasksQuery)
+		if _, err := q.ExecContext(ctx, subtasksQuery, subtasksArgs...); err != nil {
+			return fmt.Errorf("update subtasks project: %w", err)
+		}
+
+		after, err := r.lockTasks(ctx, q, affected)
+		if err != nil {
+			return err
+		}
+		if err := recordChanges(ctx, q, before, after); err != nil {
+			return err
+		}
+
+		task, err = r.withDetails(ctx, q, task)
+		return err
+	})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+func (r *TaskRepository) UpdateAssigneeByIDAndUserID(ctx context.Context, id, userID, version, assigneeID int64) (domain.Task, error) {
+	query, args, err := squirrel.Update("tasks").
+		Set("assignee_id", nullableID(assigneeID)).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix("RETURNING " + taskColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("update task assignee: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var task domain.Task
+	err = r.withTx(ctx, func(q querier) error {
+		before, err := r.lockTask(ctx, q, id, userID, version)
+		if err != nil {
+			return err
+		}
+		task, err = scanTask(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			return fmt.Errorf("update task assignee: %w", err)
+		}
+		task, err = r.withDetails(ctx, q, task)
+		if err != nil {
+			return err
+		}
+		return recordEvents(ctx, q, domain.DiffTask(before, task))
+	})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
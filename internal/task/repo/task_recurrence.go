@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (r *TaskRepository) GetRecurringWithoutNext(ctx context.Context, statuses []domain.TaskStatus, limit int) ([]domain.Task, error) {
+	builder := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.NotEq{"recurrence": ""}).
+		Where(squirrel.NotEq{"series_id": nil}).
+		Where(squirrel.Eq{"status": statuses, "deleted_at": nil}).
+		Where(squirrel.Expr("NOT EXISTS (?)", squirrel.Select("1").
+			From("tasks AS next").
+			Where("next.series_id = tasks.series_id").
+			Where("next.occurrence > tasks.occurrence"))).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select recurring tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) CreateOccurrence(ctx context.Context, task domain.Task) (domain.Task, bool, error) {
+	query, args, err := insertTask(task, 0).
+		Suffix("ON CONFLICT (series_id, occurrence) WHERE series_id IS NOT NULL DO NOTHING RETURNING id").
+		ToSql()
+	if err != nil {
+		return domain.Task{}, false, fmt.Errorf("build insert occurrence query: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	created := true
+	err = r.withTx(ctx, func(q querier) error {
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&task.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				created = false
+				return nil
+			}
+			return fmt.Errorf("insert occurrence: %w", err)
+		}
+		if err := r.replaceTags(ctx, q, task.ID, task.Tags); err != nil {
+			return err
+		}
+		if err := r.replaceReminders(ctx, q, task.ID, task.Reminders); err != nil {
+			return err
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{{TaskID: task.ID, Type: domain.TASK_CREATED}})
+	})
+	if err != nil {
+		return domain.Task{}, false, err
+	}
+	return task, created, nil
+}
+
+func (r *TaskRepository) startSeries(ctx context.Context, q querier, task *domain.Task) error {
+	query, args, err := squirrel.Update("tasks").
+		Set("series_id", task.ID).
+		Set("series_start", task.DueDate).
+		Where(squirrel.Eq{"id": task.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("start series: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("start series: %w", err)
+	}
+	task.SeriesID = task.ID
+	task.SeriesStart = task.DueDate
+	return nil
+}
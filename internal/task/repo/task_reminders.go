@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const remindAtExpr = "t.due_date - r.offset_seconds * INTERVAL '1 second'"
+
+func (r *TaskRepository) GetDueReminders(ctx context.Context, from, to time.Time, statuses []domain.TaskStatus) ([]domain.Reminder, error) {
+	query, args, err := squirrel.Select("t.id", "t.user_id", "t.description", "t.due_date", "r.offset_seconds").
+		From("task_reminders AS r").
+		Join("tasks AS t ON t.id = r.task_id").
+		Where(squirrel.Eq{"t.status": statuses, "t.deleted_at": nil}).
+		Where(remindAtExpr+" > ?", from).
+		Where(remindAtExpr+" <= ?", to).
+		OrderBy(remindAtExpr, "t.id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select due reminders: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select due reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []domain.Reminder
+	for rows.Next() {
+		var (
+			reminder domain.Reminder
+			seconds  int64
+		)
+		if err := rows.Scan(&reminder.TaskID, &reminder.UserID, &reminder.Description, &reminder.DueDate, &seconds); err != nil {
+			return nil, fmt.Errorf("select due reminders: %w", err)
+		}
+		reminder.Offset = time.Duration(seconds) * time.Second
+		reminder.RemindAt = reminder.DueDate.Add(-reminder.Offset)
+		reminders = append(reminders, reminder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select due reminders: %w", err)
+	}
+	return reminders, nil
+}
+
+func (r *TaskRepository) replaceReminders(ctx context.Context, q querier, taskID int64, offsets []time.Duration) error {
+	query, args, err := squirrel.Delete("task_reminders").
+		Where(squirrel.Eq{"task_id": taskID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task reminders: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete task reminders: %w", err)
+	}
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	builder := squirrel.Insert("task_reminders").
+		Columns("task_id", "offset_seconds").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, offset := range offsets {
+		builder = builder.Values(taskID, int64(offset/time.Second))
+	}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert task reminders: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert task reminders: %w", err)
+	}
+	return nil
+}
+
+func (r *TaskRepository) loadReminders(ctx context.Context, q querier, tasks []domain.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+
+	query, args, err := squirrel.Select("task_id", "offset_seconds").
+		From("task_reminders").
+		Where(squirrel.Eq{"task_id": ids}).
+		OrderBy("task_id", "offset_seconds").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("select task reminders: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("select task reminders: %w", err)
+	}
+	defer rows.Close()
+
+	remindersByTask := make(map[int64][]time.Duration, len(tasks))
+	for rows.Next() {
+		var taskID, seconds int64
+		if err := rows.Scan(&taskID, &seconds); err != nil {
+			return fmt.Errorf("select task reminders: %w", err)
+		}
+		remindersByTask[taskID] = append(remindersByTask[taskID], time.Duration(seconds)*time.Second)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("select task reminders: %w", err)
+	}
+
+	for i := range tasks {
+		tasks[i].Reminders = remindersByTask[tasks[i].ID]
+	}
+	return nil
+}
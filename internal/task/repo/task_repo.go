@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -17,37 +18,53 @@ type TaskRepository struct {
 	conn *sql.DB
 }
 
-const taskColumns = "id, user_id, description, status, date, due_date"
+const taskColumns = "id, user_id, description, status, date, due_date, priority, parent_id, position, recurrence, series_id, occurrence, series_start, project_id, assignee_id, deleted_at, version, board_rank"
 
 func NewTaskRepository(conn *sql.DB) TaskRepository {
 	return TaskRepository{conn: conn}
 }
 
 func (r *TaskRepository) Create(ctx context.Context, task domain.Task) (domain.Task, error) {
-	query, args, err := squirrel.Insert("tasks").
-		Columns("user_id", "description", "status", "date", "due_date").
-		Values(task.UserID, task.Description, task.Status, task.CreatedAt, task.DueDate).
-		Suffix("RETURNING id").
-		PlaceholderFormat(squirrel.Dollar).
+	var position any = 0
+	if task.ParentID != 0 {
+		position = squirrel.Expr("(SELECT COALESCE(MAX(position) + 1, 0) FROM tasks WHERE parent_id = ?)", task.ParentID)
+	}
+
+	query, args, err := insertTask(task, position).
+		Suffix("RETURNING id, position").
 		ToSql()
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("build insert tasks query: %w", err)
 	}
 	logger.Log.Infof("sql: %s", query)
 
-	var id int64
-	if err := r.conn.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
-		return domain.Task{}, fmt.Errorf("insert task: %w", err)
+	err = r.withTx(ctx, func(q querier) error {
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&task.ID, &task.Position); err != nil {
+			return fmt.Errorf("insert task: %w", err)
+		}
+		if task.Recurrence != "" && task.SeriesID == 0 {
+			if err := r.startSeries(ctx, q, &task); err != nil {
+				return err
+			}
+		}
+		if err := r.replaceTags(ctx, q, task.ID, task.Tags); err != nil {
+			return err
+		}
+		if err := r.replaceReminders(ctx, q, task.ID, task.Reminders); err != nil {
+			return err
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{{TaskID: task.ID, Type: domain.TASK_CREATED}})
+	})
+	if err != nil {
+		return domain.Task{}, err
 	}
-
-	task.ID = id
 	return task, nil
 }
 
 func (r *TaskRepository) GetByID(ctx context.Context, id int64) (domain.Task, error) {
 	query, args, err := squirrel.Select(taskColumns).
 		From("tasks").
-		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Eq{"id": id, "deleted_at": nil}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -55,28 +72,20 @@ func (r *TaskRepository) GetByID(ctx context.Context, id int64) (domain.Task, er
 	}
 	logger.Log.Infof("sql: %s", query)
 
-	task := domain.Task{}
-	err = r.conn.QueryRowContext(ctx, query, args...).Scan(
-		&task.ID,
-		&task.UserID,
-		&task.Description,
-		&task.Status,
-		&task.CreatedAt,
-		&task.DueDate,
-	)
+	task, err := scanTask(r.reader(ctx).QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Task{}, domain.ErrNotFound
 		}
 		return domain.Task{}, fmt.Errorf("select task: %w", err)
 	}
-	return task, nil
+	return r.withDetails(ctx, r.reader(ctx), task)
 }
 
 func (r *TaskRepository) GetByIDAndUserID(ctx context.Context, id, userID int64) (domain.Task, error) {
 	query, args, err := squirrel.Select(taskColumns).
 		From("tasks").
-		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Where(squirrel.Eq{"id": id, "user_id": userID, "deleted_at": nil}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -84,69 +93,96 @@ func (r *TaskRepository) GetByIDAndUserID(ctx context.Context, id, userID int64)
 	}
 	logger.Log.Infof("sql: %s", query)
 
-	task := domain.Task{}
-	err = r.conn.QueryRowContext(ctx, query, args...).Scan(
-		&task.ID,
-		&task.UserID,
-		&task.Description,
-		&task.Status,
-		&task.CreatedAt,
-		&task.DueDate,
-	)
+	task, err := scanTask(r.reader(ctx).QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Task{}, domain.ErrNotFound
 		}
 		return domain.Task{}, fmt.Errorf("select task: %w", err)
 	}
-	return task, nil
+	return r.withDetails(ctx, r.reader(ctx), task)
 }
 
-func (r *TaskRepository) GetByDueDateBetween(ctx context.Context, from, to time.Time) ([]domain.Task, error) {
-	query, args, err := squirrel.Select(taskColumns).
+func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]domain.Task, error) {
+	column := filter.Sort.Column()
+	order := "ASC"
+	cursorOp := ">"
+	if filter.Sort.Desc() {
+		order = "DESC"
+		cursorOp = "<"
+	}
+
+	builder := squirrel.Select(taskColumns).
 		From("tasks").
-		Where(squirrel.GtOrEq{"due_date": from}).
-		Where(squirrel.Lt{"due_date": to}).
-		PlaceholderFormat(squirrel.Dollar).
-		ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("select tasks: %w", err)
+		Where(squirrel.Eq{"deleted_at": nil}).
+		OrderBy(column+" "+order, "id "+order).
+		PlaceholderFormat(squirrel.Dollar)
+	builder = applyFilter(builder, filter)
+	if filter.Query != "" {
+		builder = builder.Where(squirrel.ILike{"description": "%" + escapeLike(filter.Query) + "%"})
+	}
+	if filter.After != nil {
+		builder = builder.Where(
+			fmt.Sprintf("(%s, id) %s (?, ?)", column, cursorOp),
+			filter.After.Value, filter.After.ID,
+		)
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit))
 	}
-	logger.Log.Infof("sql: %s", query)
 
-	rows, err := r.conn.QueryContext(ctx, query, args...)
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("select tasks: %w", err)
 	}
-	defer rows.Close()
+	logger.Log.Infof("sql: %s", query)
 
-	var tasks []domain.Task
-	for rows.Next() {
-		task := domain.Task{}
-		if err := rows.Scan(
-			&task.ID,
-			&task.UserID,
-			&task.Description,
-			&task.Status,
-			&task.CreatedAt,
-			&task.DueDate,
-		); err != nil {
-			return nil, fmt.Errorf("select tasks: %w", err)
-		}
-		tasks = append(tasks, task)
+	return r.queryTasks(ctx, query, args...)
+}
+
+// applyFilter adds the owner, status, date, priority, tag, project and
+// assignee conditions of filter. Query, cursor and limit are left to the caller.
+func applyFilter(builder squirrel.SelectBuilder, filter domain.TaskFilter) squirrel.SelectBuilder {
+	if filter.UserID != 0 {
+		builder = builder.Where(squirrel.Eq{"user_id": filter.UserID})
 	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("select tasks: %w", err)
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(squirrel.Eq{"status": filter.Statuses})
 	}
-	return tasks, nil
+	if !filter.DueFrom.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"due_date": filter.DueFrom})
+	}
+	if !filter.DueTo.IsZero() {
+		builder = builder.Where(squirrel.Lt{"due_date": filter.DueTo})
+	}
+	if !filter.CreatedFrom.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"date": filter.CreatedFrom})
+	}
+	if !filter.CreatedTo.IsZero() {
+		builder = builder.Where(squirrel.Lt{"date": filter.CreatedTo})
+	}
+	if len(filter.Priorities) > 0 {
+		builder = builder.Where(squirrel.Eq{"priority": filter.Priorities})
+	}
+	if len(filter.Tags) > 0 {
+		builder = builder.Where(hasAnyTag(filter.Tags))
+	}
+	if filter.ProjectID != 0 {
+		builder = builder.Where(squirrel.Eq{"project_id": filter.ProjectID})
+	}
+	if filter.AssigneeID != 0 {
+		builder = builder.Where(squirrel.Eq{"assignee_id": filter.AssigneeID})
+	}
+	return builder
 }
 
-func (r *TaskRepository) GetByUserIDAndDueDateBetween(ctx context.Context, userID int64, from, to time.Time) ([]domain.Task, error) {
+func (r *TaskRepository) GetByDueDateBetween(ctx context.Context, from, to time.Time) ([]domain.Task, error) {
 	query, args, err := squirrel.Select(taskColumns).
 		From("tasks").
-		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"deleted_at": nil}).
 		Where(squirrel.GtOrEq{"due_date": from}).
 		Where(squirrel.Lt{"due_date": to}).
+		OrderBy("due_date", "id").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -154,39 +190,34 @@ func (r *TaskRepository) GetByUserIDAndDueDateBetween(ctx context.Context, userI
 	}
 	logger.Log.Infof("sql: %s", query)
 
-	rows, err := r.conn.QueryContext(ctx, query, args...)
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) GetByUserIDAndDueDateBetween(ctx context.Context, userID int64, from, to time.Time) ([]domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"user_id": userID, "deleted_at": nil}).
+		Where(squirrel.GtOrEq{"due_date": from}).
+		Where(squirrel.Lt{"due_date": to}).
+		OrderBy("due_date", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("select tasks: %w", err)
 	}
-	defer rows.Close()
+	logger.Log.Infof("sql: %s", query)
 
-	var tasks []domain.Task
-	for rows.Next() {
-		task := domain.Task{}
-		if err := rows.Scan(
-			&task.ID,
-			&task.UserID,
-			&task.Description,
-			&task.Status,
-			&task.CreatedAt,
-			&task.DueDate,
-		); err != nil {
-			return nil, fmt.Errorf("select tasks: %w", err)
-		}
-		tasks = append(tasks, task)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("select tasks: %w", err)
-	}
-	return tasks, nil
+	return r.queryTasks(ctx, query, args...)
 }
 
 func (r *TaskRepository) GetByDueDateBetweenAndStatusNot(ctx context.Context, from, to time.Time, status domain.TaskStatus) ([]domain.Task, error) {
 	query, args, err := squirrel.Select(taskColumns).
 		From("tasks").
+		Where(squirrel.Eq{"deleted_at": nil}).
 		Where(squirrel.GtOrEq{"due_date": from}).
 		Where(squirrel.Lt{"due_date": to}).
 		Where(squirrel.NotEq{"status": status}).
+		OrderBy("due_date", "id").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -194,80 +225,341 @@ func (r *TaskRepository) GetByDueDateBetweenAndStatusNot(ctx context.Context, fr
 	}
 	logger.Log.Infof("sql: %s", query)
 
-	rows, err := r.conn.QueryContext(ctx, query, args...)
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) UpdateStatusByIDAndUserID(ctx context.Context, id, userID, version int64, from domain.TaskStatus, change domain.StatusChange) (domain.Task, error) {
+	builder := squirrel.Update("tasks").
+		Set("status", change.To).
+		Set("board_rank", nil).
+		Set("version", nextVersion).
+		Where(squirrel.Eq{"id": id, "user_id": userID, "status": from}).
+		Suffix("RETURNING " + taskColumns).
+		PlaceholderFormat(squirrel.Dollar)
+	if !change.DueDate.IsZero() {
+		builder = builder.Set("due_date", change.DueDate)
+	}
+	if change.To == domain.COMPLETED {
+		builder = builder.Where(noIncompleteSubtasks())
+	}
+	if change.To == domain.AT_WORK {
+		builder = builder.Where(noOpenBlockers())
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("select tasks: %w", err)
+		return domain.Task{}, fmt.Errorf("update task: %w", err)
 	}
-	defer rows.Close()
+	logger.Log.Infof("sql: %s", query)
 
-	var tasks []domain.Task
-	for rows.Next() {
-		task := domain.Task{}
-		if err := rows.Scan(
-			&task.ID,
-			&task.UserID,
-			&task.Description,
-			&task.Status,
-			&task.CreatedAt,
-			&task.DueDate,
-		); err != nil {
-			return nil, fmt.Errorf("select tasks: %w", err)
+	var task domain.Task
+	err = r.withTx(ctx, func(q querier) error {
+		before, err := r.lockTask(ctx, q, id, userID, version)
+		if err != nil {
+			return err
 		}
-		tasks = append(tasks, task)
+		task, err = scanTask(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrInvalidTransition
+			}
+			return fmt.Errorf("update task: %w", err)
+		}
+		task, err = r.withDetails(ctx, q, task)
+		if err != nil {
+			return err
+		}
+		return recordChanges(ctx, q, []domain.Task{before}, []domain.Task{task})
+	})
+	if err != nil {
+		return domain.Task{}, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("select tasks: %w", err)
+	return task, nil
+}
+
+func (r *TaskRepository) UpdateStatusByIDsAndUserID(ctx context.Context, ids []int64, userID int64, from []domain.TaskStatus, to domain.TaskStatus) error {
+	if len(ids) == 0 || len(from) == 0 {
+		return nil
 	}
-	return tasks, nil
+
+	return r.withTx(ctx, func(q querier) error {
+		tasks, err := r.lockTasks(ctx, q, squirrel.Eq{"id": ids, "user_id": userID, "status": from})
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		locked := make([]int64, 0, len(tasks))
+		events := make([]domain.TaskEvent, 0, len(tasks))
+		for _, task := range tasks {
+			locked = append(locked, task.ID)
+			events = append(events, domain.StatusChanged(task.ID, task.Status, to))
+		}
+
+		query, args, err := squirrel.Update("tasks").
+			Set("status", to).
+			Set("board_rank", nil).
+			Set("version", nextVersion).
+			Where(squirrel.Eq{"id": locked}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("update tasks: %w", err)
+		}
+		logger.Log.Infof("sql: %s", query)
+
+		if _, err := q.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("update tasks: %w", err)
+		}
+		return recordEvents(ctx, q, events)
+	})
 }
 
-func (r *TaskRepository) UpdateStatusByIDAndUserID(ctx context.Context, id, userID int64, status domain.TaskStatus) (domain.Task, error) {
-	query, args, err := squirrel.Update("tasks").
-		Set("status", status).
+func (r *TaskRepository) UpdateByIDAndUserID(ctx context.Context, id, userID, version int64, update domain.TaskUpdate) (domain.Task, error) {
+	builder := squirrel.Update("tasks").
+		Set("version", nextVersion).
 		Where(squirrel.Eq{"id": id, "user_id": userID}).
 		Suffix("RETURNING " + taskColumns).
-		PlaceholderFormat(squirrel.Dollar).
-		ToSql()
+		PlaceholderFormat(squirrel.Dollar)
+	if update.Description != nil {
+		builder = builder.Set("description", *update.Description)
+	}
+	if update.DueDate != nil {
+		builder = builder.Set("due_date", *update.DueDate)
+	}
+	if update.Priority != nil {
+		builder = builder.Set("priority", *update.Priority)
+	}
+	if update.Recurrence != nil {
+		builder = builder.Set("recurrence", *update.Recurrence)
+		if *update.Recurrence != "" {
+			// A series started by this update begins at the new due date.
+			seriesStart := squirrel.Expr("COALESCE(series_start, due_date)")
+			if update.DueDate != nil {
+				seriesStart = squirrel.Expr("COALESCE(series_start, ?)", *update.DueDate)
+			}
+			builder = builder.
+				Set("series_id", squirrel.Expr("COALESCE(series_id, id)")).
+				Set("series_start", seriesStart)
+		}
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("update task: %w", err)
 	}
 	logger.Log.Infof("sql: %s", query)
 
+	var task domain.Task
+	err = r.withTx(ctx, func(q querier) error {
+		before, err := r.lockTask(ctx, q, id, userID, version)
+		if err != nil {
+			return err
+		}
+		task, err = scanTask(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			return fmt.Errorf("update task: %w", err)
+		}
+		if update.Tags != nil {
+			if err := r.replaceTags(ctx, q, task.ID, *update.Tags); err != nil {
+				return err
+			}
+		}
+		if update.Reminders != nil {
+			if err := r.replaceReminders(ctx, q, task.ID, *update.Reminders); err != nil {
+				return err
+			}
+		}
+		task, err = r.withDetails(ctx, q, task)
+		if err != nil {
+			return err
+		}
+		return recordChanges(ctx, q, []domain.Task{before}, []domain.Task{task})
+	})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+var nextVersion = squirrel.Expr("version + 1")
+
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// InTx runs fn in a single transaction; repository calls made with the
+// context passed to fn join it instead of opening their own.
+func (r *TaskRepository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func (r *TaskRepository) withTx(ctx context.Context, fn func(q querier) error) error {
+	return inTx(ctx, r.conn, fn)
+}
+
+// reader returns the transaction joined through InTx, if any, so that reads
+// made inside it see its own writes and the rows it has locked.
+func (r *TaskRepository) reader(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return r.conn
+}
+
+func inTx(ctx context.Context, conn *sql.DB, fn func(q querier) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(tx)
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func insertTask(task domain.Task, position any) squirrel.InsertBuilder {
+	var seriesStart sql.NullTime
+	if task.SeriesID != 0 {
+		seriesStart = sql.NullTime{Time: task.SeriesStart, Valid: true}
+	}
+
+	return squirrel.Insert("tasks").
+		Columns(
+			"user_id", "description", "status", "date", "due_date", "priority",
+			"parent_id", "position", "recurrence", "series_id", "occurrence", "series_start", "project_id", "assignee_id",
+		).
+		Values(
+			task.UserID, task.Description, task.Status, task.CreatedAt, task.DueDate, task.Priority,
+			nullableID(task.ParentID), position, task.Recurrence, nullableID(task.SeriesID), task.Occurrence, seriesStart,
+			nullableID(task.ProjectID), nullableID(task.AssigneeID),
+		).
+		PlaceholderFormat(squirrel.Dollar)
+}
+
+func scanTask(row rowScanner) (domain.Task, error) {
 	task := domain.Task{}
-	err = r.conn.QueryRowContext(ctx, query, args...).Scan(
+	var (
+		parentID    sql.NullInt64
+		seriesID    sql.NullInt64
+		seriesStart sql.NullTime
+		projectID   sql.NullInt64
+		assigneeID  sql.NullInt64
+		deletedAt   sql.NullTime
+		boardRank   sql.NullString
+	)
+	err := row.Scan(
 		&task.ID,
 		&task.UserID,
 		&task.Description,
 		&task.Status,
 		&task.CreatedAt,
 		&task.DueDate,
+		&task.Priority,
+		&parentID,
+		&task.Position,
+		&task.Recurrence,
+		&seriesID,
+		&task.Occurrence,
+		&seriesStart,
+		&projectID,
+		&assigneeID,
+		&deletedAt,
+		&task.Version,
+		&boardRank,
 	)
+	task.ParentID = parentID.Int64
+	task.ProjectID = projectID.Int64
+	task.AssigneeID = assigneeID.Int64
+	task.SeriesID = seriesID.Int64
+	task.SeriesStart = seriesStart.Time
+	task.DeletedAt = deletedAt.Time
+	task.BoardRank = boardRank.String
+	return task, err
+}
+
+func nullableID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+func (r *TaskRepository) queryTasks(ctx context.Context, query string, args ...any) ([]domain.Task, error) {
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.Task{}, domain.ErrNotFound
+		return nil, fmt.Errorf("select tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select tasks: %w", err)
 		}
-		return domain.Task{}, fmt.Errorf("update task: %w", err)
+		tasks = append(tasks, task)
 	}
-	return task, nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select tasks: %w", err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("select tasks: %w", err)
+	}
+	if err := r.loadDetails(ctx, r.reader(ctx), tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
 }
 
-func (r *TaskRepository) UpdateStatusByIDs(ctx context.Context, ids []int64, status domain.TaskStatus) error {
-	if len(ids) == 0 {
-		return nil
+func (r *TaskRepository) withDetails(ctx context.Context, q querier, task domain.Task) (domain.Task, error) {
+	tasks := []domain.Task{task}
+	if err := r.loadDetails(ctx, q, tasks); err != nil {
+		return domain.Task{}, err
 	}
+	return tasks[0], nil
+}
 
-	query, args, err := squirrel.Update("tasks").
-		Set("status", status).
-		Where(squirrel.Eq{"id": ids}).
-		PlaceholderFormat(squirrel.Dollar).
-		ToSql()
-	if err != nil {
-		return fmt.Errorf("update tasks: %w", err)
+func (r *TaskRepository) loadDetails(ctx context.Context, q querier, tasks []domain.Task) error {
+	if err := r.loadTags(ctx, q, tasks); err != nil {
+		return err
 	}
-	logger.Log.Infof("sql: %s", query)
-
-	if _, err := r.conn.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("update tasks: %w", err)
+	if err := r.loadReminders(ctx, q, tasks); err != nil {
+		return err
 	}
-	return nil
+	return r.loadBlockers(ctx, q, tasks)
 }
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// ts_headline marks matches with control characters, which are stripped
+// from the description first. The snippet is HTML-escaped before they become
+// <mark> tags, so markup written into a description never reaches a client
+// as HTML.
+const (
+	searchMarkStart       = "\x02"
+	searchMarkStop        = "\x03"
+	searchHeadlineOptions = "StartSel=" + searchMarkStart + ", StopSel=" + searchMarkStop + ", MaxFragments=2, MaxWords=20, MinWords=5"
+)
+
+var searchMarks = strings.NewReplacer(searchMarkStart, "<mark>", searchMarkStop, "</mark>")
+
+// Search matches filter.Query against the task search vector and returns the
+// hits ordered by rank, each with an HTML snippet of the description in
+// which the matches are wrapped in <mark>.
+func (r *TaskRepository) Search(ctx context.Context, filter domain.TaskFilter, offset int) ([]domain.SearchHit, error) {
+	builder := squirrel.Select(taskColumns).
+		Column("ts_rank_cd(search_vector, q) AS rank").
+		Column("ts_headline('simple', translate(description, ?, ''), q, ?) AS snippet", searchMarkStart+searchMarkStop, searchHeadlineOptions).
+		From("tasks").
+		JoinClause(squirrel.Expr("CROSS JOIN websearch_to_tsquery('simple', ?) AS q", filter.Query)).
+		Where("search_vector @@ q").
+		Where(squirrel.Eq{"deleted_at": nil}).
+		OrderBy("rank DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar)
+	builder = applyFilter(builder, filter)
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit))
+	}
+	if offset > 0 {
+		builder = builder.Offset(uint64(offset))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("search tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		hits  []domain.SearchHit
+		tasks []domain.Task
+	)
+	for rows.Next() {
+		var hit domain.SearchHit
+		task, err := scanTask(withColumns(rows, &hit.Rank, &hit.Snippet))
+		if err != nil {
+			return nil, fmt.Errorf("search tasks: %w", err)
+		}
+		hit.Snippet = searchMarks.Replace(html.EscapeString(hit.Snippet))
+		hits = append(hits, hit)
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("search tasks: %w", err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("search tasks: %w", err)
+	}
+	if err := r.loadDetails(ctx, r.reader(ctx), tasks); err != nil {
+		return nil, err
+	}
+	for i := range hits {
+		hits[i].Task = tasks[i]
+	}
+	return hits, nil
+}
+
+// withColumns scans the task columns followed by extra trailing columns.
+func withColumns(row rowScanner, extra ...any) rowScanner {
+	return extraScanner{row: row, extra: extra}
+}
+
+type extraScanner struct {
+	row   rowScanner
+	extra []any
+}
+
+func (s extraScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
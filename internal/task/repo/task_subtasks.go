@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (r *TaskRepository) GetByParentIDs(ctx context.Context, parentIDs []int64) ([]domain.Task, error) {
+	if len(parentIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"parent_id": parentIDs, "deleted_at": nil}).
+		OrderBy("parent_id", "position", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select subtasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) CountIncompleteByParentID(ctx context.Context, parentID int64) (int, error) {
+	query, args, err := squirrel.Select("COUNT(*)").
+		From("tasks").
+		Where(squirrel.Eq{"parent_id": parentID, "deleted_at": nil}).
+		Where(squirrel.NotEq{"status": domain.COMPLETED}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("count subtasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var count int
+	if err := r.reader(ctx).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count subtasks: %w", err)
+	}
+	return count, nil
+}
+
+func (r *TaskRepository) ReorderByParentID(ctx context.Context, parentID int64, ids []int64) error {
+	return r.withTx(ctx, func(q querier) error {
+		query, args, err := squirrel.Select("COUNT(*)").
+			From("tasks").
+			Where(squirrel.Eq{"parent_id": parentID, "deleted_at": nil}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("count subtasks: %w", err)
+		}
+		logger.Log.Infof("sql: %s", query)
+
+		var count int
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+			return fmt.Errorf("count subtasks: %w", err)
+		}
+		if count != len(ids) {
+			return domain.ErrSubtaskMismatch
+		}
+
+		for position, id := range ids {
+			query, args, err := squirrel.Update("tasks").
+				Set("position", position).
+				Set("version", nextVersion).
+				Where(squirrel.Eq{"id": id, "parent_id": parentID, "deleted_at": nil}).
+				PlaceholderFormat(squirrel.Dollar).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("reorder subtasks: %w", err)
+			}
+			logger.Log.Infof("sql: %s", query)
+
+			result, err := q.ExecContext(ctx, query, args...)
+			if err != nil {
+				return fmt.Errorf("reorder subtasks: %w", err)
+			}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("reorder subtasks: %w", err)
+			}
+			if affected == 0 {
+				return domain.ErrSubtaskMismatch
+			}
+		}
+		return nil
+	})
+}
+
+func noIncompleteSubtasks() squirrel.Sqlizer {
+	return squirrel.Expr("NOT EXISTS (?)", squirrel.Select("1").
+		From("tasks AS subtasks").
+		Where("subtasks.parent_id = tasks.id").
+		Where(squirrel.Eq{"subtasks.deleted_at": nil}).
+		Where(squirrel.NotEq{"subtasks.status": domain.COMPLETED}))
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (r *TaskRepository) replaceTags(ctx context.Context, q querier, taskID int64, tags []string) error {
+	query, args, err := squirrel.Delete("task_tags").
+		Where(squirrel.Eq{"task_id": taskID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task tags: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete task tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	builder := squirrel.Insert("task_tags").
+		Columns("task_id", "tag").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, tag := range tags {
+		builder = builder.Values(taskID, tag)
+	}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert task tags: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert task tags: %w", err)
+	}
+	return nil
+}
+
+func (r *TaskRepository) loadTags(ctx context.Context, q querier, tasks []domain.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+
+	query, args, err := squirrel.Select("task_id", "tag").
+		From("task_tags").
+		Where(squirrel.Eq{"task_id": ids}).
+		OrderBy("task_id", "tag").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("select task tags: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("select task tags: %w", err)
+	}
+	defer rows.Close()
+
+	tagsByTask := make(map[int64][]string, len(tasks))
+	for rows.Next() {
+		var (
+			taskID int64
+			tag    string
+		)
+		if err := rows.Scan(&taskID, &tag); err != nil {
+			return fmt.Errorf("select task tags: %w", err)
+		}
+		tagsByTask[taskID] = append(tagsByTask[taskID], tag)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("select task tags: %w", err)
+	}
+
+	for i := range tasks {
+		tasks[i].Tags = tagsByTask[tasks[i].ID]
+	}
+	return nil
+}
+
+func hasAnyTag(tags []string) squirrel.Sqlizer {
+	return squirrel.Expr("id IN (?)", squirrel.Select("task_id").
+		From("task_tags").
+		Where(squirrel.Eq{"tag": tags}))
+}
@@ -0,0 +1,228 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (r *TaskRepository) DeleteByIDAndUserID(ctx context.Context, id, userID, version int64, deletedAt time.Time) error {
+	query, args, err := squirrel.Update("tasks").
+		PrefixExpr(subtree(squirrel.Eq{"id": id, "user_id": userID, "deleted_at": nil}, "tasks.deleted_at IS NULL")).
+		Set("deleted_at", deletedAt).
+		Set("version", nextVersion).
+		Where("id IN (SELECT id FROM subtree)").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.withTx(ctx, func(q querier) error {
+		if _, err := r.lockTask(ctx, q, id, userID, version); err != nil {
+			return err
+		}
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("delete task: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("delete task: %w", err)
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{{TaskID: id, Type: domain.TASK_DELETED}})
+	})
+}
+
+func (r *TaskRepository) GetDeletedByID(ctx context.Context, id int64) (domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"id": id}).
+		Where(trashedRoot()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("select deleted task: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	task, err := scanTask(r.reader(ctx).QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Task{}, domain.ErrNotFound
+		}
+		return domain.Task{}, fmt.Errorf("select deleted task: %w", err)
+	}
+	return r.withDetails(ctx, r.reader(ctx), task)
+}
+
+func (r *TaskRepository) GetDeletedByUserID(ctx context.Context, userID int64) ([]domain.Task, error) {
+	query, args, err := squirrel.Select(taskColumns).
+		From("tasks").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(trashedRoot()).
+		OrderBy("deleted_at DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select deleted tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTasks(ctx, query, args...)
+}
+
+func (r *TaskRepository) RestoreByIDAndUserID(ctx context.Context, id, userID int64) (domain.Task, error) {
+	root := squirrel.And{squirrel.Eq{"id": id, "user_id": userID}, trashedRoot()}
+	query, args, err := squirrel.Update("tasks").
+		PrefixExpr(subtree(root, "tasks.deleted_at = subtree.deleted_at")).
+		Set("deleted_at", nil).
+		Set("board_rank", nil).
+		Set("version", nextVersion).
+		Where("id IN (SELECT id FROM subtree)").
+		Suffix("RETURNING " + taskColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("restore task: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var task domain.Task
+	err = r.withTx(ctx, func(q querier) error {
+		rows, err := q.QueryContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("restore task: %w", err)
+		}
+		defer rows.Close()
+
+		found := false
+		for rows.Next() {
+			restored, err := scanTask(rows)
+			if err != nil {
+				return fmt.Errorf("restore task: %w", err)
+			}
+			if restored.ID == id {
+				task, found = restored, true
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("restore task: %w", err)
+		}
+		if err := rows.Close(); err != nil {
+			return fmt.Errorf("restore task: %w", err)
+		}
+		if !found {
+			return domain.ErrNotFound
+		}
+
+		if err := recordEvents(ctx, q, []domain.TaskEvent{{TaskID: id, Type: domain.TASK_RESTORED}}); err != nil {
+			return err
+		}
+		task, err = r.withDetails(ctx, q, task)
+		return err
+	})
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return task, nil
+}
+
+func (r *TaskRepository) PurgeByIDAndUserID(ctx context.Context, id, userID int64) error {
+	query, args, err := squirrel.Delete("tasks").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Where(trashedRoot()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("purge task: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.withTx(ctx, func(q querier) error {
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("purge task: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("purge task: %w", err)
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+		return recordEvents(ctx, q, []domain.TaskEvent{{TaskID: id, Type: domain.TASK_PURGED}})
+	})
+}
+
+func (r *TaskRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query, args, err := squirrel.Delete("tasks").
+		Where(squirrel.Lt{"deleted_at": before}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("purge deleted tasks: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var purged int
+	err = r.withTx(ctx, func(q querier) error {
+		ids, err := queryIDs(ctx, q, query, args...)
+		if err != nil {
+			return fmt.Errorf("purge deleted tasks: %w", err)
+		}
+		events := make([]domain.TaskEvent, 0, len(ids))
+		for _, id := range ids {
+			events = append(events, domain.TaskEvent{TaskID: id, Type: domain.TASK_PURGED})
+		}
+		purged = len(ids)
+		return recordEvents(ctx, q, events)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// trashedRoot matches deleted tasks whose parent is still live, so a subtree
+// that was deleted together shows up in the trash as a single entry.
+func trashedRoot() squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.NotEq{"deleted_at": nil},
+		squirrel.Expr("NOT EXISTS (?)", squirrel.Select("1").
+			From("tasks AS parent").
+			Where("parent.id = tasks.parent_id").
+			Where(squirrel.NotEq{"parent.deleted_at": nil})),
+	}
+}
+
+// inSubtree matches the rows of subtree(root, child), for use in a WHERE
+// clause.
+func inSubtree(root squirrel.Sqlizer, child string) squirrel.Sqlizer {
+	return squirrel.ConcatExpr("id IN (", subtree(root, child), " SELECT id FROM subtree)")
+}
+
+func subtree(root squirrel.Sqlizer, child string) squirrel.Sqlizer {
+	return squirrel.ConcatExpr(
+		"WITH RECURSIVE subtree AS (",
+		squirrel.Select("id", "deleted_at").From("tasks").Where(root),
+		" UNION ALL ",
+		squirrel.Select("tasks.id", "tasks.deleted_at").
+			From("tasks").
+			Join("subtree ON tasks.parent_id = subtree.id").
+			Where(child),
+		")",
+	)
+}
@@ -0,0 +1,295 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type TaskTemplateRepository struct {
+	conn *sql.DB
+}
+
+const templateColumns = "id, user_id, name, (SELECT COUNT(*) FROM task_template_items WHERE task_template_items.template_id = task_templates.id), created_at"
+
+func NewTaskTemplateRepository(conn *sql.DB) TaskTemplateRepository {
+	return TaskTemplateRepository{conn: conn}
+}
+
+// Create stores a template together with its item trees.
+func (r *TaskTemplateRepository) Create(ctx context.Context, template domain.TaskTemplate) (domain.TaskTemplate, error) {
+	query, args, err := squirrel.Insert("task_templates").
+		Columns("user_id", "name", "created_at").
+		Values(template.UserID, template.Name, template.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("build insert task templates query: %w", err)
+	}
+
+	err = inTx(ctx, r.conn, func(q querier) error {
+		logger.Log.Infof("sql: %s", query)
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&template.ID); err != nil {
+			return fmt.Errorf("insert task template: %w", err)
+		}
+		return insertTemplateItems(ctx, q, template.ID, 0, template.Items)
+	})
+	if err != nil {
+		return domain.TaskTemplate{}, err
+	}
+	template.ItemCount = domain.CountItems(template.Items)
+	return template, nil
+}
+
+func insertTemplateItems(ctx context.Context, q querier, templateID, parentID int64, items []domain.TemplateItem) error {
+	var parent sql.NullInt64
+	if parentID != 0 {
+		parent = sql.NullInt64{Int64: parentID, Valid: true}
+	}
+
+	for position, item := range items {
+		query, args, err := squirrel.Insert("task_template_items").
+			Columns("template_id", "parent_id", "position", "description", "due_offset_days", "priority").
+			Values(templateID, parent, position, item.Description, item.DueOffsetDays, item.Priority).
+			Suffix("RETURNING id").
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build insert task template items query: %w", err)
+		}
+		logger.Log.Infof("sql: %s", query)
+
+		var id int64
+		if err := q.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+			return fmt.Errorf("insert task template item: %w", err)
+		}
+		if err := insertTemplateItemTags(ctx, q, id, item.Tags); err != nil {
+			return err
+		}
+		if err := insertTemplateItems(ctx, q, templateID, id, item.Subtasks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertTemplateItemTags(ctx context.Context, q querier, itemID int64, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	builder := squirrel.Insert("task_template_item_tags").
+		Columns("item_id", "tag").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, tag := range tags {
+		builder = builder.Values(itemID, tag)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("insert task template item tags: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	if _, err := q.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert task template item tags: %w", err)
+	}
+	return nil
+}
+
+func (r *TaskTemplateRepository) GetByIDAndUserID(ctx context.Context, id, userID int64) (domain.TaskTemplate, error) {
+	query, args, err := squirrel.Select(templateColumns).
+		From("task_templates").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("select task template: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	template, err := scanTemplate(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TaskTemplate{}, domain.ErrNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("select task template: %w", err)
+	}
+
+	template.Items, err = r.loadItems(ctx, template.ID)
+	if err != nil {
+		return domain.TaskTemplate{}, err
+	}
+	return template, nil
+}
+
+// GetByUserID lists the templates of userID without their items.
+func (r *TaskTemplateRepository) GetByUserID(ctx context.Context, userID int64) ([]domain.TaskTemplate, error) {
+	query, args, err := squirrel.Select(templateColumns).
+		From("task_templates").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("name", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task templates: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []domain.TaskTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select task templates: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task templates: %w", err)
+	}
+	return templates, nil
+}
+
+func (r *TaskTemplateRepository) DeleteByIDAndUserID(ctx context.Context, id, userID int64) error {
+	query, args, err := squirrel.Delete("task_templates").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete task template: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete task template: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete task template: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// loadItems reads the items of a template and assembles them into trees.
+func (r *TaskTemplateRepository) loadItems(ctx context.Context, templateID int64) ([]domain.TemplateItem, error) {
+	query, args, err := squirrel.Select("id", "parent_id", "description", "due_offset_days", "priority").
+		From("task_template_items").
+		Where(squirrel.Eq{"template_id": templateID}).
+		OrderBy("position", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task template items: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task template items: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	items := make(map[int64]domain.TemplateItem)
+	children := make(map[int64][]int64)
+	for rows.Next() {
+		var (
+			id       int64
+			parentID sql.NullInt64
+			item     domain.TemplateItem
+		)
+		if err := rows.Scan(&id, &parentID, &item.Description, &item.DueOffsetDays, &item.Priority); err != nil {
+			return nil, fmt.Errorf("select task template items: %w", err)
+		}
+		ids = append(ids, id)
+		items[id] = item
+		children[parentID.Int64] = append(children[parentID.Int64], id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task template items: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	tags, err := r.loadItemTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var build func(parentID int64) []domain.TemplateItem
+	build = func(parentID int64) []domain.TemplateItem {
+		var tree []domain.TemplateItem
+		for _, id := range children[parentID] {
+			item := items[id]
+			item.Tags = tags[id]
+			item.Subtasks = build(id)
+			tree = append(tree, item)
+		}
+		return tree
+	}
+	return build(0), nil
+}
+
+func (r *TaskTemplateRepository) loadItemTags(ctx context.Context, itemIDs []int64) (map[int64][]string, error) {
+	query, args, err := squirrel.Select("item_id", "tag").
+		From("task_template_item_tags").
+		Where(squirrel.Eq{"item_id": itemIDs}).
+		OrderBy("item_id", "tag").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select task template item tags: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select task template item tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[int64][]string)
+	for rows.Next() {
+		var (
+			itemID int64
+			tag    string
+		)
+		if err := rows.Scan(&itemID, &tag); err != nil {
+			return nil, fmt.Errorf("select task template item tags: %w", err)
+		}
+		tags[itemID] = append(tags[itemID], tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select task template item tags: %w", err)
+	}
+	return tags, nil
+}
+
+func scanTemplate(row rowScanner) (domain.TaskTemplate, error) {
+	template := domain.TaskTemplate{}
+	err := row.Scan(
+		&template.ID,
+		&template.UserID,
+		&template.Name,
+		&template.ItemCount,
+		&template.CreatedAt,
+	)
+	return template, err
+}
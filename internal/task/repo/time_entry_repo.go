@@ -0,0 +1,275 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type TimeEntryRepository struct {
+	conn *sql.DB
+}
+
+const timeEntryColumns = "id, task_id, user_id, (SELECT project_id FROM tasks WHERE tasks.id = time_entries.task_id), started_at, ended_at, note, manual, created_at"
+
+func NewTimeEntryRepository(conn *sql.DB) TimeEntryRepository {
+	return TimeEntryRepository{conn: conn}
+}
+
+// Start stops the running timer of the entry's user, if any, and inserts the
+// entry as the new running one.
+func (r *TimeEntryRepository) Start(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	stopQuery, stopArgs, err := squirrel.Update("time_entries").
+		Set("ended_at", squirrel.Expr("GREATEST(started_at, ?)", entry.StartedAt)).
+		Where(squirrel.Eq{"user_id": entry.UserID, "ended_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TimeEntry{}, fmt.Errorf("stop running time entry: %w", err)
+	}
+
+	var started domain.TimeEntry
+	err = inTx(ctx, r.conn, func(q querier) error {
+		logger.Log.Infof("sql: %s", stopQuery)
+		if _, err := q.ExecContext(ctx, stopQuery, stopArgs...); err != nil {
+			return fmt.Errorf("stop running time entry: %w", err)
+		}
+		started, err = r.insert(ctx, q, entry)
+		return err
+	})
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return started, nil
+}
+
+func (r *TimeEntryRepository) Create(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	var created domain.TimeEntry
+	err := inTx(ctx, r.conn, func(q querier) error {
+		var err error
+		created, err = r.insert(ctx, q, entry)
+		return err
+	})
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return created, nil
+}
+
+func (r *TimeEntryRepository) insert(ctx context.Context, q querier, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	var endedAt sql.NullTime
+	if !entry.EndedAt.IsZero() {
+		endedAt = sql.NullTime{Time: entry.EndedAt, Valid: true}
+	}
+
+	query, args, err := squirrel.Insert("time_entries").
+		Columns("task_id", "user_id", "started_at", "ended_at", "note", "manual", "created_at").
+		Values(entry.TaskID, entry.UserID, entry.StartedAt, endedAt, entry.Note, entry.Manual, entry.CreatedAt).
+		Suffix("RETURNING " + timeEntryColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TimeEntry{}, fmt.Errorf("build insert time entries query: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	created, err := scanTimeEntry(q.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return domain.TimeEntry{}, fmt.Errorf("insert time entry: %w", err)
+	}
+	return created, nil
+}
+
+func (r *TimeEntryRepository) GetRunningByUserID(ctx context.Context, userID int64) (domain.TimeEntry, error) {
+	query, args, err := squirrel.Select(timeEntryColumns).
+		From("time_entries").
+		Where(squirrel.Eq{"user_id": userID, "ended_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TimeEntry{}, fmt.Errorf("select running time entry: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	entry, err := scanTimeEntry(r.conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TimeEntry{}, domain.ErrNotFound
+		}
+		return domain.TimeEntry{}, fmt.Errorf("select running time entry: %w", err)
+	}
+	return entry, nil
+}
+
+func (r *TimeEntryRepository) GetByTaskID(ctx context.Context, taskID int64) ([]domain.TimeEntry, error) {
+	query, args, err := squirrel.Select(timeEntryColumns).
+		From("time_entries").
+		Where(squirrel.Eq{"task_id": taskID}).
+		OrderBy("started_at", "id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select time entries: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTimeEntries(ctx, query, args...)
+}
+
+// GetByUserIDBetween returns the entries of userID that overlap [from, to),
+// optionally restricted to the tasks of one project. Running entries are
+// treated as lasting until now.
+func (r *TimeEntryRepository) GetByUserIDBetween(ctx context.Context, userID, projectID int64, from, to time.Time) ([]domain.TimeEntry, error) {
+	builder := squirrel.Select(timeEntryColumns).
+		From("time_entries").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Lt{"started_at": to}).
+		Where("COALESCE(ended_at, NOW()) > ?", from).
+		OrderBy("started_at", "id").
+		PlaceholderFormat(squirrel.Dollar)
+	if projectID != 0 {
+		builder = builder.Where(squirrel.Expr("task_id IN (?)", squirrel.Select("id").
+			From("tasks").
+			Where(squirrel.Eq{"project_id": projectID})))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("select time entries: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	return r.queryTimeEntries(ctx, query, args...)
+}
+
+func (r *TimeEntryRepository) StopRunningByTaskIDAndUserID(ctx context.Context, taskID, userID int64, endedAt time.Time) (domain.TimeEntry, error) {
+	query, args, err := squirrel.Update("time_entries").
+		Set("ended_at", squirrel.Expr("GREATEST(started_at, ?)", endedAt)).
+		Where(squirrel.Eq{"task_id": taskID, "user_id": userID, "ended_at": nil}).
+		Suffix("RETURNING " + timeEntryColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return domain.TimeEntry{}, fmt.Errorf("stop time entry: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var entry domain.TimeEntry
+	err = inTx(ctx, r.conn, func(q querier) error {
+		entry, err = scanTimeEntry(q.QueryRowContext(ctx, query, args...))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("stop time entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	return entry, nil
+}
+
+func (r *TimeEntryRepository) StopRunningByTaskID(ctx context.Context, taskID int64, endedAt time.Time) (int, error) {
+	query, args, err := squirrel.Update("time_entries").
+		Set("ended_at", squirrel.Expr("GREATEST(started_at, ?)", endedAt)).
+		Where(squirrel.Eq{"task_id": taskID, "ended_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("stop time entries: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	var stopped int64
+	err = inTx(ctx, r.conn, func(q querier) error {
+		result, err := q.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("stop time entries: %w", err)
+		}
+		stopped, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("stop time entries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(stopped), nil
+}
+
+func (r *TimeEntryRepository) DeleteByIDAndUserID(ctx context.Context, id, taskID, userID int64) error {
+	query, args, err := squirrel.Delete("time_entries").
+		Where(squirrel.Eq{"id": id, "task_id": taskID, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete time entry: %w", err)
+	}
+	logger.Log.Infof("sql: %s", query)
+
+	result, err := r.conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete time entry: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete time entry: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *TimeEntryRepository) queryTimeEntries(ctx context.Context, query string, args ...any) ([]domain.TimeEntry, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select time entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("select time entries: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select time entries: %w", err)
+	}
+	return entries, nil
+}
+
+func scanTimeEntry(row rowScanner) (domain.TimeEntry, error) {
+	entry := domain.TimeEntry{}
+	var (
+		projectID sql.NullInt64
+		endedAt   sql.NullTime
+	)
+	err := row.Scan(
+		&entry.ID,
+		&entry.TaskID,
+		&entry.UserID,
+		&projectID,
+		&entry.StartedAt,
+		&endedAt,
+		&entry.Note,
+		&entry.Manual,
+		&entry.CreatedAt,
+	)
+	entry.ProjectID = projectID.Int64
+	entry.EndedAt = endedAt.Time
+	return entry, err
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accountpb "task-tracker/gen/private/account"
+	"task-tracker/internal/task/domain"
+	"task-tracker/internal/task/usecase"
+)
+
+type AccountClientAdapter struct {
+	client accountpb.UsersServiceClient
+}
+
+func NewAccountClientAdapter(client accountpb.UsersServiceClient) AccountClientAdapter {
+	return AccountClientAdapter{client: client}
+}
+
+func (a AccountClientAdapter) GetUserIDByEmail(ctx context.Context, email string) (int64, error) {
+	resp, err := a.client.GetUserByEmail(ctx, &accountpb.GetUserByEmailRequest{Email: email})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+	return resp.GetUser().GetId(), nil
+}
+
+func (a AccountClientAdapter) GetEmailsByIDs(ctx context.Context, ids []int64) (map[int64]string, error) {
+	resp, err := a.client.GetUsersByIDs(ctx, &accountpb.GetUsersByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]string, len(resp.GetUsers()))
+	for _, user := range resp.GetUsers() {
+		result[user.GetId()] = user.GetEmail()
+	}
+	return result, nil
+}
+
+func (a AccountClientAdapter) GetUserIDByCalendarFeedToken(ctx context.Context, token string) (int64, error) {
+	resp, err := a.client.GetUserByCalendarFeedToken(ctx, &accountpb.GetUserByCalendarFeedTokenRequest{Token: token})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+	return resp.GetUser().GetId(), nil
+}
+
+var (
+	_ usecase.UserDirectory     = AccountClientAdapter{}
+	_ usecase.CalendarFeedUsers = AccountClientAdapter{}
+)
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const attachmentChunkSize = 64 * 1024
+
+func (h *TaskHandler) UploadAttachment(stream taskpb.TaskService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Log.Infof("grpc upload attachment: recv error err=%v", err)
+		return status.Error(codes.InvalidArgument, "missing upload info")
+	}
+	info := first.GetInfo()
+	if info == nil {
+		logger.Log.Infof("grpc upload attachment: missing upload info")
+		return status.Error(codes.InvalidArgument, "missing upload info")
+	}
+	if info.GetJwt() == "" {
+		logger.Log.Infof("grpc upload attachment: missing token")
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	draft := domain.Attachment{
+		TaskID:      info.GetTaskId(),
+		FileName:    info.GetFileName(),
+		ContentType: info.GetContentType(),
+		Size:        info.GetSize(),
+	}
+	attachment, err := h.attachments.Upload(stream.Context(), info.GetJwt(), draft, &uploadReader{stream: stream})
+	if err != nil {
+		return mapTaskError(err)
+	}
+	return stream.SendAndClose(&taskpb.AttachmentResponse{Attachment: toProtoAttachment(attachment)})
+}
+
+func (h *TaskHandler) DownloadAttachment(req *taskpb.DownloadAttachmentRequest, stream taskpb.TaskService_DownloadAttachmentServer) error {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc download attachment: missing token")
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	attachment, body, err := h.attachments.Open(stream.Context(), req.GetJwt(), req.GetTaskId(), req.GetId())
+	if err != nil {
+		return mapTaskError(err)
+	}
+	defer body.Close()
+
+	err = stream.Send(&taskpb.DownloadAttachmentResponse{
+		Payload: &taskpb.DownloadAttachmentResponse_Attachment{Attachment: toProtoAttachment(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&taskpb.DownloadAttachmentResponse{
+				Payload: &taskpb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			logger.Log.Infof("grpc download attachment: read error id=%d err=%v", attachment.ID, err)
+			return status.Error(codes.Internal, "internal error")
+		}
+	}
+}
+
+func (h *TaskHandler) ListAttachments(ctx context.Context, req *taskpb.ListAttachmentsRequest) (*taskpb.AttachmentsResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list attachments: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	attachments, err := h.attachments.List(ctx, req.GetJwt(), req.GetTaskId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.AttachmentsResponse{Attachments: make([]*taskpb.Attachment, 0, len(attachments))}
+	for _, attachment := range attachments {
+		resp.Attachments = append(resp.Attachments, toProtoAttachment(attachment))
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) DeleteAttachment(ctx context.Context, req *taskpb.DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete attachment: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.attachments.Delete(ctx, req.GetJwt(), req.GetTaskId(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toProtoAttachment(attachment domain.Attachment) *taskpb.Attachment {
+	return &taskpb.Attachment{
+		Id:          attachment.ID,
+		TaskId:      attachment.TaskID,
+		UserId:      attachment.UserID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Unix(),
+	}
+}
+
+// uploadReader exposes the chunks of an upload stream as an io.Reader.
+type uploadReader struct {
+	stream taskpb.TaskService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) GetBoard(ctx context.Context, req *taskpb.GetBoardRequest) (*taskpb.BoardResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get board: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	columns, err := h.svc.GetBoard(ctx, req.GetJwt(), req.GetProjectId(), int(req.GetColumnSize()))
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.BoardResponse{Columns: make([]*taskpb.BoardColumn, 0, len(columns))}
+	for _, column := range columns {
+		resp.Columns = append(resp.Columns, &taskpb.BoardColumn{
+			Status: toProtoStatus(column.Status),
+			Tasks:  toProtoTasks(column.Tasks),
+			Total:  int32(column.Total),
+		})
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) MoveTaskOnBoard(ctx context.Context, req *taskpb.MoveTaskOnBoardRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc move task on board: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	statusValue, err := toDomainStatus(req.GetStatus())
+	if err != nil {
+		logger.Log.Infof("grpc move task on board: invalid status err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	change := domain.StatusChange{
+		To:         statusValue,
+		Reopen:     req.GetReopen(),
+		StartTimer: req.GetStartTimer(),
+	}
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc move task on board: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.MoveOnBoard(ctx, req.GetJwt(), req.GetId(), version, change, req.GetAfterId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) ListTaskComments(ctx context.Context, req *taskpb.ListTaskCommentsRequest) (*taskpb.TaskCommentsResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list task comments: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	comments, nextPageToken, err := h.svc.ListComments(ctx, req.GetJwt(), req.GetTaskId(), int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.TaskCommentsResponse{Comments: make([]*taskpb.TaskComment, 0, len(comments)), NextPageToken: nextPageToken}
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, toProtoComment(comment))
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) AddTaskComment(ctx context.Context, req *taskpb.AddTaskCommentRequest) (*taskpb.TaskCommentResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc add task comment: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	comment, err := h.svc.AddComment(ctx, req.GetJwt(), req.GetTaskId(), req.GetBody())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+func (h *TaskHandler) UpdateTaskComment(ctx context.Context, req *taskpb.UpdateTaskCommentRequest) (*taskpb.TaskCommentResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc update task comment: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	comment, err := h.svc.UpdateComment(ctx, req.GetJwt(), req.GetTaskId(), req.GetId(), req.GetBody())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+func (h *TaskHandler) DeleteTaskComment(ctx context.Context, req *taskpb.DeleteTaskCommentRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete task comment: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.DeleteComment(ctx, req.GetJwt(), req.GetTaskId(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toProtoComment(comment domain.Comment) *taskpb.TaskComment {
+	return &taskpb.TaskComment{
+		Id:        comment.ID,
+		TaskId:    comment.TaskID,
+		UserId:    comment.UserID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.Unix(),
+		UpdatedAt: toUnix(comment.UpdatedAt),
+	}
+}
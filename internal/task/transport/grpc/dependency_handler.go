@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) ListTaskBlockers(ctx context.Context, req *taskpb.ListTaskBlockersRequest) (*taskpb.TasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list task blockers: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tasks, err := h.svc.ListBlockers(ctx, req.GetJwt(), req.GetTaskId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TasksResponse{Tasks: toProtoTasks(tasks)}, nil
+}
+
+func (h *TaskHandler) AddTaskBlocker(ctx context.Context, req *taskpb.AddTaskBlockerRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc add task blocker: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	task, err := h.svc.AddBlocker(ctx, req.GetJwt(), req.GetTaskId(), req.GetBlockerId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) RemoveTaskBlocker(ctx context.Context, req *taskpb.RemoveTaskBlockerRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc remove task blocker: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	task, err := h.svc.RemoveBlocker(ctx, req.GetJwt(), req.GetTaskId(), req.GetBlockerId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
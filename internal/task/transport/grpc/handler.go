@@ -3,10 +3,16 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	taskpb "task-tracker/gen/public/task"
 	"task-tracker/internal/task/domain"
@@ -14,13 +20,22 @@ import (
 	"task-tracker/pkg/logger"
 )
 
+// expectedVersionKey carries the If-Match version forwarded by the gateway.
+const expectedVersionKey = "x-expected-version"
+
+// versionMismatchReason marks the Aborted status of a version mismatch, which
+// the gateway reports as 412 Precondition Failed.
+const versionMismatchReason = "VERSION_MISMATCH"
+
 type TaskHandler struct {
 	taskpb.UnimplementedTaskServiceServer
-	svc *usecase.TaskService
+	svc         *usecase.TaskService
+	attachments *usecase.AttachmentService
+	calendar    *usecase.CalendarService
 }
 
-func NewTaskHandler(svc *usecase.TaskService) *TaskHandler {
-	return &TaskHandler{svc: svc}
+func NewTaskHandler(svc *usecase.TaskService, attachments *usecase.AttachmentService, calendar *usecase.CalendarService) *TaskHandler {
+	return &TaskHandler{svc: svc, attachments: attachments, calendar: calendar}
 }
 
 func (h *TaskHandler) GetTask(ctx context.Context, req *taskpb.GetTaskRequest) (*taskpb.TaskResponse, error) {
@@ -36,6 +51,49 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *taskpb.GetTaskRequest) (
 	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
 }
 
+func (h *TaskHandler) ListTasks(ctx context.Context, req *taskpb.ListTasksRequest) (*taskpb.ListTasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list tasks: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	filter, err := toDomainFilter(req)
+	if err != nil {
+		logger.Log.Infof("grpc list tasks: invalid filter err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, nextPageToken, err := h.svc.List(ctx, req.GetJwt(), filter, int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ListTasksResponse{Tasks: toProtoTasks(tasks), NextPageToken: nextPageToken}, nil
+}
+
+func (h *TaskHandler) SearchTasks(ctx context.Context, req *taskpb.SearchTasksRequest) (*taskpb.SearchTasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc search tasks: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	filter, err := toDomainSearchFilter(req)
+	if err != nil {
+		logger.Log.Infof("grpc search tasks: invalid filter err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hits, nextPageToken, err := h.svc.Search(ctx, req.GetJwt(), filter, int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.SearchTasksResponse{Hits: make([]*taskpb.TaskSearchHit, 0, len(hits)), NextPageToken: nextPageToken}
+	for _, hit := range hits {
+		resp.Hits = append(resp.Hits, &taskpb.TaskSearchHit{Task: toProtoTask(hit.Task), Rank: hit.Rank, Snippet: hit.Snippet})
+	}
+	return resp, nil
+}
+
 func (h *TaskHandler) GetTodayTasks(ctx context.Context, req *taskpb.GetTasksRequest) (*taskpb.TasksResponse, error) {
 	if req.GetJwt() == "" {
 		logger.Log.Infof("grpc get today: missing token")
@@ -54,13 +112,27 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 		logger.Log.Infof("grpc create task: missing token")
 		return nil, status.Error(codes.Unauthenticated, "missing token")
 	}
-	if req.GetDueDate() <= 0 {
+	if req.GetDueDate() <= 0 && req.GetDueDay() == "" && req.GetDueText() == "" {
 		logger.Log.Infof("grpc create task: invalid due date")
 		return nil, status.Error(codes.InvalidArgument, "invalid due date")
 	}
 
-	dueDate := time.Unix(req.GetDueDate(), 0)
-	task, err := h.svc.Create(ctx, req.GetJwt(), req.GetDescription(), dueDate)
+	priority, err := toDomainPriority(req.GetPriority())
+	if err != nil {
+		logger.Log.Infof("grpc create task: invalid priority err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	draft := domain.Task{
+		Description: req.GetDescription(),
+		DueDate:     fromUnix(req.GetDueDate()),
+		Priority:    priority,
+		Tags:        req.GetTags(),
+		Recurrence:  req.GetRecurrence(),
+		Reminders:   toDomainReminders(req.GetReminderMinutes()),
+		ProjectID:   req.GetProjectId(),
+	}
+	task, err := h.svc.Create(ctx, req.GetJwt(), draft, req.GetDueDay(), req.GetDueText())
 	if err != nil {
 		return nil, mapTaskError(err)
 	}
@@ -79,13 +151,524 @@ func (h *TaskHandler) UpdateTaskStatus(ctx context.Context, req *taskpb.UpdateTa
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	task, err := h.svc.UpdateStatus(ctx, req.GetJwt(), req.GetId(), statusValue)
+	change := domain.StatusChange{
+		To:         statusValue,
+		Reopen:     req.GetReopen(),
+		DueDate:    fromUnix(req.GetDueDate()),
+		StartTimer: req.GetStartTimer(),
+	}
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc update task status: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.UpdateStatus(ctx, req.GetJwt(), req.GetId(), version, change)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc update task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	update, err := toDomainUpdate(req)
+	if err != nil {
+		logger.Log.Infof("grpc update task: invalid update err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc update task: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.Update(ctx, req.GetJwt(), req.GetId(), version, update)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) DeleteTask(ctx context.Context, req *taskpb.DeleteTaskRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc delete task: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.svc.Delete(ctx, req.GetJwt(), req.GetId(), version); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TaskHandler) BatchUpdateTasks(ctx context.Context, req *taskpb.BatchUpdateTasksRequest) (*taskpb.BatchUpdateTasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc batch update tasks: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	update, err := toDomainBatchUpdate(req)
+	if err != nil {
+		logger.Log.Infof("grpc batch update tasks: invalid update err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	mode, err := toDomainBatchMode(req.GetMode())
+	if err != nil {
+		logger.Log.Infof("grpc batch update tasks: invalid mode err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var filter *domain.TaskFilter
+	if req.GetFilter() != nil {
+		value, err := toDomainBatchFilter(req.GetFilter())
+		if err != nil {
+			logger.Log.Infof("grpc batch update tasks: invalid filter err=%v", err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter = &value
+	}
+
+	results, err := h.svc.BatchUpdate(ctx, req.GetJwt(), req.GetIds(), filter, update, mode)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.BatchUpdateTasksResponse{Results: make([]*taskpb.BatchItemResult, 0, len(results))}
+	for _, result := range results {
+		item := &taskpb.BatchItemResult{Id: result.ID, Ok: result.Err == nil}
+		if result.Err != nil {
+			st := status.Convert(mapTaskError(result.Err))
+			item.Code = int32(st.Code())
+			item.Error = st.Message()
+			resp.Failed++
+		} else {
+			if result.Task.ID != 0 {
+				item.Task = toProtoTask(result.Task)
+			}
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) AddSubtask(ctx context.Context, req *taskpb.AddSubtaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc add subtask: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+	if req.GetDueDate() < 0 {
+		logger.Log.Infof("grpc add subtask: invalid due date")
+		return nil, status.Error(codes.InvalidArgument, "invalid due date")
+	}
+
+	priority, err := toDomainPriority(req.GetPriority())
+	if err != nil {
+		logger.Log.Infof("grpc add subtask: invalid priority err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	draft := domain.Task{
+		Description: req.GetDescription(),
+		DueDate:     fromUnix(req.GetDueDate()),
+		Priority:    priority,
+		Tags:        req.GetTags(),
+	}
+	task, err := h.svc.AddSubtask(ctx, req.GetJwt(), req.GetParentId(), draft)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) ReorderSubtasks(ctx context.Context, req *taskpb.ReorderSubtasksRequest) (*taskpb.TasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc reorder subtasks: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tasks, err := h.svc.ReorderSubtasks(ctx, req.GetJwt(), req.GetParentId(), req.GetSubtaskIds())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TasksResponse{Tasks: toProtoTasks(tasks)}, nil
+}
+
+func (h *TaskHandler) ToggleSubtask(ctx context.Context, req *taskpb.ToggleSubtaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc toggle subtask: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc toggle subtask: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.ToggleSubtask(ctx, req.GetJwt(), req.GetId(), version)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) MoveTask(ctx context.Context, req *taskpb.MoveTaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc move task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc move task: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.MoveToProject(ctx, req.GetJwt(), req.GetId(), version, req.GetProjectId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) AssignTask(ctx context.Context, req *taskpb.AssignTaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc assign task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Log.Infof("grpc assign task: invalid expected version err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	task, err := h.svc.Assign(ctx, req.GetJwt(), req.GetId(), version, req.GetAssigneeId())
 	if err != nil {
 		return nil, mapTaskError(err)
 	}
 	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
 }
 
+func (h *TaskHandler) GetTaskHistory(ctx context.Context, req *taskpb.GetTaskHistoryRequest) (*taskpb.TaskHistoryResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get task history: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	events, err := h.svc.GetHistory(ctx, req.GetJwt(), req.GetId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.TaskHistoryResponse{Events: make([]*taskpb.TaskEvent, 0, len(events))}
+	for _, event := range events {
+		resp.Events = append(resp.Events, toProtoEvent(event))
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) ListTrash(ctx context.Context, req *taskpb.ListTrashRequest) (*taskpb.TasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list trash: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tasks, err := h.svc.ListTrash(ctx, req.GetJwt())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TasksResponse{Tasks: toProtoTasks(tasks)}, nil
+}
+
+func (h *TaskHandler) RestoreTask(ctx context.Context, req *taskpb.RestoreTaskRequest) (*taskpb.TaskResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc restore task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	task, err := h.svc.Restore(ctx, req.GetJwt(), req.GetId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskResponse{Task: toProtoTask(task)}, nil
+}
+
+func (h *TaskHandler) PurgeTask(ctx context.Context, req *taskpb.PurgeTaskRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc purge task: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.Purge(ctx, req.GetJwt(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version != 0 {
+		return version, nil
+	}
+	values := metadata.ValueFromIncomingContext(ctx, expectedVersionKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0, errors.New("invalid expected version")
+	}
+	return parsed, nil
+}
+
+func toDomainUpdate(req *taskpb.UpdateTaskRequest) (domain.TaskUpdate, error) {
+	update := domain.TaskUpdate{}
+	setDescription := func() {
+		description := req.GetDescription()
+		update.Description = &description
+	}
+	setDueDate := func() {
+		dueDate := fromUnix(req.GetDueDate())
+		update.DueDate = &dueDate
+	}
+	setPriority := func() error {
+		priority, err := toDomainPriority(req.GetPriority())
+		if err != nil {
+			return err
+		}
+		update.Priority = &priority
+		return nil
+	}
+	setTags := func() {
+		tags := req.GetTags()
+		update.Tags = &tags
+	}
+	setRecurrence := func() {
+		recurrence := req.GetRecurrence()
+		update.Recurrence = &recurrence
+	}
+	setReminders := func() {
+		reminders := toDomainReminders(req.GetReminderMinutes())
+		update.Reminders = &reminders
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.GetDescription() != "" {
+			setDescription()
+		}
+		if req.GetDueDate() != 0 {
+			setDueDate()
+		}
+		if req.GetPriority() != taskpb.TaskPriority_TASK_PRIORITY_NONE {
+			if err := setPriority(); err != nil {
+				return domain.TaskUpdate{}, err
+			}
+		}
+		if len(req.GetTags()) > 0 {
+			setTags()
+		}
+		if req.GetRecurrence() != "" {
+			setRecurrence()
+		}
+		if len(req.GetReminderMinutes()) > 0 {
+			setReminders()
+		}
+		return update, nil
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "description":
+			setDescription()
+		case "due_date":
+			setDueDate()
+		case "priority":
+			if err := setPriority(); err != nil {
+				return domain.TaskUpdate{}, err
+			}
+		case "tags":
+			setTags()
+		case "recurrence":
+			setRecurrence()
+		case "reminder_minutes":
+			setReminders()
+		default:
+			return domain.TaskUpdate{}, fmt.Errorf("unknown update mask path %q", path)
+		}
+	}
+	return update, nil
+}
+
+func toDomainFilter(req *taskpb.ListTasksRequest) (domain.TaskFilter, error) {
+	filter := domain.TaskFilter{
+		DueFrom:      fromUnix(req.GetDueFrom()),
+		DueTo:        fromUnix(req.GetDueTo()),
+		CreatedFrom:  fromUnix(req.GetCreatedFrom()),
+		CreatedTo:    fromUnix(req.GetCreatedTo()),
+		Query:        strings.TrimSpace(req.GetQuery()),
+		Tags:         req.GetTags(),
+		ProjectID:    req.GetProjectId(),
+		AssignedToMe: req.GetAssignedToMe(),
+	}
+
+	var err error
+	if filter.Priorities, err = toDomainPriorities(req.GetPriorities()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	if filter.Statuses, err = toDomainStatuses(req.GetStatuses()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	if filter.Sort, err = toDomainSort(req.GetSort()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	return filter, nil
+}
+
+func toDomainPriorities(protoPriorities []taskpb.TaskPriority) ([]domain.Priority, error) {
+	var priorities []domain.Priority
+	for _, protoPriority := range protoPriorities {
+		priority, err := toDomainPriority(protoPriority)
+		if err != nil {
+			return nil, err
+		}
+		priorities = append(priorities, priority)
+	}
+	return priorities, nil
+}
+
+func toDomainStatuses(protoStatuses []taskpb.TaskStatus) ([]domain.TaskStatus, error) {
+	var statuses []domain.TaskStatus
+	for _, protoStatus := range protoStatuses {
+		statusValue, err := toDomainStatus(protoStatus)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, statusValue)
+	}
+	return statuses, nil
+}
+
+func toDomainSearchFilter(req *taskpb.SearchTasksRequest) (domain.TaskFilter, error) {
+	filter := domain.TaskFilter{
+		DueFrom:      fromUnix(req.GetDueFrom()),
+		DueTo:        fromUnix(req.GetDueTo()),
+		CreatedFrom:  fromUnix(req.GetCreatedFrom()),
+		CreatedTo:    fromUnix(req.GetCreatedTo()),
+		Query:        req.GetQuery(),
+		Tags:         req.GetTags(),
+		ProjectID:    req.GetProjectId(),
+		AssignedToMe: req.GetAssignedToMe(),
+	}
+
+	var err error
+	if filter.Priorities, err = toDomainPriorities(req.GetPriorities()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	if filter.Statuses, err = toDomainStatuses(req.GetStatuses()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	return filter, nil
+}
+
+func toDomainBatchFilter(req *taskpb.BatchTaskFilter) (domain.TaskFilter, error) {
+	filter := domain.TaskFilter{
+		DueFrom:      fromUnix(req.GetDueFrom()),
+		DueTo:        fromUnix(req.GetDueTo()),
+		CreatedFrom:  fromUnix(req.GetCreatedFrom()),
+		CreatedTo:    fromUnix(req.GetCreatedTo()),
+		Query:        strings.TrimSpace(req.GetQuery()),
+		Tags:         req.GetTags(),
+		ProjectID:    req.GetProjectId(),
+		AssignedToMe: req.GetAssignedToMe(),
+	}
+
+	var err error
+	if filter.Priorities, err = toDomainPriorities(req.GetPriorities()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	if filter.Statuses, err = toDomainStatuses(req.GetStatuses()); err != nil {
+		return domain.TaskFilter{}, err
+	}
+	return filter, nil
+}
+
+func toDomainBatchUpdate(req *taskpb.BatchUpdateTasksRequest) (domain.BatchUpdate, error) {
+	switch req.GetAction() {
+	case taskpb.BatchAction_BATCH_ACTION_SET_STATUS:
+		statusValue, err := toDomainStatus(req.GetStatus())
+		if err != nil {
+			return domain.BatchUpdate{}, err
+		}
+		return domain.BatchUpdate{
+			Action: domain.BATCH_SET_STATUS,
+			Status: domain.StatusChange{To: statusValue, Reopen: req.GetReopen(), DueDate: fromUnix(req.GetDueDate())},
+		}, nil
+	case taskpb.BatchAction_BATCH_ACTION_SET_DUE_DATE:
+		return domain.BatchUpdate{Action: domain.BATCH_SET_DUE_DATE, DueDate: fromUnix(req.GetDueDate())}, nil
+	case taskpb.BatchAction_BATCH_ACTION_MOVE_TO_PROJECT:
+		return domain.BatchUpdate{Action: domain.BATCH_MOVE_TO_PROJECT, ProjectID: req.GetProjectId()}, nil
+	case taskpb.BatchAction_BATCH_ACTION_SET_TAGS:
+		return domain.BatchUpdate{Action: domain.BATCH_SET_TAGS, Tags: req.GetTags()}, nil
+	case taskpb.BatchAction_BATCH_ACTION_DELETE:
+		return domain.BatchUpdate{Action: domain.BATCH_DELETE}, nil
+	default:
+		return domain.BatchUpdate{}, errors.New("unknown batch action")
+	}
+}
+
+func toDomainBatchMode(mode taskpb.BatchMode) (domain.BatchMode, error) {
+	switch mode {
+	case taskpb.BatchMode_BATCH_MODE_ALL_OR_NOTHING:
+		return domain.BATCH_ALL_OR_NOTHING, nil
+	case taskpb.BatchMode_BATCH_MODE_BEST_EFFORT:
+		return domain.BATCH_BEST_EFFORT, nil
+	default:
+		return domain.BATCH_ALL_OR_NOTHING, errors.New("unknown batch mode")
+	}
+}
+
+func toDomainSort(sort taskpb.TaskSortOrder) (domain.TaskSort, error) {
+	switch sort {
+	case taskpb.TaskSortOrder_TASK_SORT_ORDER_DUE_DATE_ASC:
+		return domain.SORT_DUE_DATE_ASC, nil
+	case taskpb.TaskSortOrder_TASK_SORT_ORDER_DUE_DATE_DESC:
+		return domain.SORT_DUE_DATE_DESC, nil
+	case taskpb.TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_ASC:
+		return domain.SORT_CREATED_AT_ASC, nil
+	case taskpb.TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_DESC:
+		return domain.SORT_CREATED_AT_DESC, nil
+	default:
+		return domain.SORT_DUE_DATE_ASC, errors.New("unknown sort order")
+	}
+}
+
+func fromUnix(value int64) time.Time {
+	if value <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(value, 0)
+}
+
+func toUnix(value time.Time) int64 {
+	if value.IsZero() {
+		return 0
+	}
+	return value.Unix()
+}
+
 func toDomainStatus(status taskpb.TaskStatus) (domain.TaskStatus, error) {
 	switch status {
 	case taskpb.TaskStatus_TASK_STATUS_CREATED:
@@ -103,12 +686,26 @@ func toDomainStatus(status taskpb.TaskStatus) (domain.TaskStatus, error) {
 
 func toProtoTask(task domain.Task) *taskpb.Task {
 	return &taskpb.Task{
-		Id:          task.ID,
-		UserId:      task.UserID,
-		Description: task.Description,
-		Status:      toProtoStatus(task.Status),
-		CreatedAt:   task.CreatedAt.Unix(),
-		DueDate:     task.DueDate.Unix(),
+		Id:              task.ID,
+		UserId:          task.UserID,
+		Description:     task.Description,
+		Status:          toProtoStatus(task.Status),
+		CreatedAt:       task.CreatedAt.Unix(),
+		DueDate:         task.DueDate.Unix(),
+		Priority:        toProtoPriority(task.Priority),
+		Tags:            task.Tags,
+		ParentId:        task.ParentID,
+		Position:        int32(task.Position),
+		Subtasks:        toProtoTasks(task.Subtasks),
+		Recurrence:      task.Recurrence,
+		ReminderMinutes: toProtoReminders(task.Reminders),
+		ProjectId:       task.ProjectID,
+		AssigneeId:      task.AssigneeID,
+		DeletedAt:       toUnix(task.DeletedAt),
+		Version:         task.Version,
+		BlockedBy:       task.BlockedBy,
+		Blocked:         task.Blocked(),
+		BoardRank:       task.BoardRank,
 	}
 }
 
@@ -124,6 +721,26 @@ func toProtoTasks(tasks []domain.Task) []*taskpb.Task {
 	return result
 }
 
+func toDomainReminders(minutes []int32) []time.Duration {
+	reminders := make([]time.Duration, 0, len(minutes))
+	for _, m := range minutes {
+		reminders = append(reminders, time.Duration(m)*time.Minute)
+	}
+	return reminders
+}
+
+func toProtoReminders(reminders []time.Duration) []int32 {
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	minutes := make([]int32, 0, len(reminders))
+	for _, reminder := range reminders {
+		minutes = append(minutes, int32(reminder/time.Minute))
+	}
+	return minutes
+}
+
 func toProtoStatus(status domain.TaskStatus) taskpb.TaskStatus {
 	switch status {
 	case domain.CREATED:
@@ -139,6 +756,86 @@ func toProtoStatus(status domain.TaskStatus) taskpb.TaskStatus {
 	}
 }
 
+func toDomainPriority(priority taskpb.TaskPriority) (domain.Priority, error) {
+	switch priority {
+	case taskpb.TaskPriority_TASK_PRIORITY_NONE:
+		return domain.NO_PRIORITY, nil
+	case taskpb.TaskPriority_TASK_PRIORITY_LOW:
+		return domain.LOW, nil
+	case taskpb.TaskPriority_TASK_PRIORITY_MEDIUM:
+		return domain.MEDIUM, nil
+	case taskpb.TaskPriority_TASK_PRIORITY_HIGH:
+		return domain.HIGH, nil
+	case taskpb.TaskPriority_TASK_PRIORITY_URGENT:
+		return domain.URGENT, nil
+	default:
+		return domain.NO_PRIORITY, errors.New("unknown priority")
+	}
+}
+
+func toProtoPriority(priority domain.Priority) taskpb.TaskPriority {
+	switch priority {
+	case domain.LOW:
+		return taskpb.TaskPriority_TASK_PRIORITY_LOW
+	case domain.MEDIUM:
+		return taskpb.TaskPriority_TASK_PRIORITY_MEDIUM
+	case domain.HIGH:
+		return taskpb.TaskPriority_TASK_PRIORITY_HIGH
+	case domain.URGENT:
+		return taskpb.TaskPriority_TASK_PRIORITY_URGENT
+	default:
+		return taskpb.TaskPriority_TASK_PRIORITY_NONE
+	}
+}
+
+func toProtoEvent(event domain.TaskEvent) *taskpb.TaskEvent {
+	return &taskpb.TaskEvent{
+		Id:        event.ID,
+		TaskId:    event.TaskID,
+		Type:      toProtoEventType(event.Type),
+		Field:     event.Field,
+		OldValue:  event.OldValue,
+		NewValue:  event.NewValue,
+		ActorId:   event.ActorID,
+		Source:    toProtoEventSource(event.Source),
+		CreatedAt: event.CreatedAt.Unix(),
+	}
+}
+
+func toProtoEventType(eventType domain.TaskEventType) taskpb.TaskEventType {
+	switch eventType {
+	case domain.TASK_UPDATED:
+		return taskpb.TaskEventType_TASK_EVENT_TYPE_UPDATED
+	case domain.TASK_DELETED:
+		return taskpb.TaskEventType_TASK_EVENT_TYPE_DELETED
+	case domain.TASK_RESTORED:
+		return taskpb.TaskEventType_TASK_EVENT_TYPE_RESTORED
+	case domain.TASK_PURGED:
+		return taskpb.TaskEventType_TASK_EVENT_TYPE_PURGED
+	default:
+		return taskpb.TaskEventType_TASK_EVENT_TYPE_CREATED
+	}
+}
+
+func toProtoEventSource(source domain.EventSource) taskpb.TaskEventSource {
+	switch source {
+	case domain.SOURCE_USER:
+		return taskpb.TaskEventSource_TASK_EVENT_SOURCE_USER
+	case domain.SOURCE_SCHEDULER:
+		return taskpb.TaskEventSource_TASK_EVENT_SOURCE_SCHEDULER
+	default:
+		return taskpb.TaskEventSource_TASK_EVENT_SOURCE_SYSTEM
+	}
+}
+
+func versionMismatchError(err error) error {
+	st, detailErr := status.New(codes.Aborted, err.Error()).WithDetails(&errdetails.ErrorInfo{Reason: versionMismatchReason})
+	if detailErr != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return st.Err()
+}
+
 func mapTaskError(err error) error {
 	switch {
 	case errors.Is(err, usecase.ErrInvalidToken):
@@ -149,6 +846,19 @@ func mapTaskError(err error) error {
 		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, domain.ErrNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidTransition), errors.Is(err, domain.ErrIncompleteSubtasks),
+		errors.Is(err, domain.ErrProjectArchived), errors.Is(err, domain.ErrTaskBlocked),
+		errors.Is(err, domain.ErrDependencyCycle), errors.Is(err, domain.ErrInvalidPosition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrSubtaskMismatch), errors.Is(err, domain.ErrUnsupportedContentType),
+		errors.Is(err, domain.ErrInvalidDueText), errors.Is(err, domain.ErrAmbiguousDueText):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVersionMismatch):
+		return versionMismatchError(err)
+	case errors.Is(err, domain.ErrBatchAborted):
+		return status.Error(codes.Aborted, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal error")
 	}
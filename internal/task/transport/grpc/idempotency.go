@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/internal/task/usecase"
+	"task-tracker/pkg/logger"
+)
+
+// idempotencyKeyHeader carries the Idempotency-Key header forwarded by the gateway.
+const idempotencyKeyHeader = "idempotency-key"
+
+var idempotentMethods = map[string]struct{}{
+	taskpb.TaskService_CreateTask_FullMethodName:              {},
+	taskpb.TaskService_UpdateTaskStatus_FullMethodName:        {},
+	taskpb.TaskService_UpdateTask_FullMethodName:              {},
+	taskpb.TaskService_DeleteTask_FullMethodName:              {},
+	taskpb.TaskService_BatchUpdateTasks_FullMethodName:        {},
+	taskpb.TaskService_AddSubtask_FullMethodName:              {},
+	taskpb.TaskService_ReorderSubtasks_FullMethodName:         {},
+	taskpb.TaskService_ToggleSubtask_FullMethodName:           {},
+	taskpb.TaskService_MoveTask_FullMethodName:                {},
+	taskpb.TaskService_AssignTask_FullMethodName:              {},
+	taskpb.TaskService_RestoreTask_FullMethodName:             {},
+	taskpb.TaskService_PurgeTask_FullMethodName:               {},
+	taskpb.TaskService_AddTaskComment_FullMethodName:          {},
+	taskpb.TaskService_UpdateTaskComment_FullMethodName:       {},
+	taskpb.TaskService_DeleteTaskComment_FullMethodName:       {},
+	taskpb.TaskService_AddTaskBlocker_FullMethodName:          {},
+	taskpb.TaskService_RemoveTaskBlocker_FullMethodName:       {},
+	taskpb.TaskService_StartTimer_FullMethodName:              {},
+	taskpb.TaskService_StopTimer_FullMethodName:               {},
+	taskpb.TaskService_AddTimeEntry_FullMethodName:            {},
+	taskpb.TaskService_DeleteTimeEntry_FullMethodName:         {},
+	taskpb.TaskService_MoveTaskOnBoard_FullMethodName:         {},
+	taskpb.TaskService_DeleteAttachment_FullMethodName:        {},
+	taskpb.TaskService_CreateTaskTemplate_FullMethodName:      {},
+	taskpb.TaskService_SaveTaskTemplate_FullMethodName:        {},
+	taskpb.TaskService_DeleteTaskTemplate_FullMethodName:      {},
+	taskpb.TaskService_InstantiateTaskTemplate_FullMethodName: {},
+	taskpb.ProjectService_CreateProject_FullMethodName:        {},
+	taskpb.ProjectService_UpdateProject_FullMethodName:        {},
+	taskpb.ProjectService_DeleteProject_FullMethodName:        {},
+	taskpb.ProjectService_AddProjectMember_FullMethodName:     {},
+	taskpb.ProjectService_UpdateProjectMember_FullMethodName:  {},
+	taskpb.ProjectService_RemoveProjectMember_FullMethodName:  {},
+}
+
+type authenticatedRequest interface {
+	proto.Message
+	GetJwt() string
+}
+
+// IdempotencyInterceptor replays the stored response for mutating calls that
+// repeat an Idempotency-Key, so client retries do not apply a change twice.
+func IdempotencyInterceptor(svc *usecase.IdempotencyService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyHeader)
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(authenticatedRequest)
+		if !ok || msg.GetJwt() == "" {
+			return handler(ctx, req)
+		}
+		key := values[0]
+
+		hash, err := requestHash(ctx, msg)
+		if err != nil {
+			logger.Log.Infof("grpc idempotency: hash error method=%s err=%v", info.FullMethod, err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		reservation, stored, err := svc.Begin(ctx, msg.GetJwt(), key, info.FullMethod, hash)
+		if err != nil {
+			if errors.Is(err, usecase.ErrInvalidToken) {
+				return handler(ctx, req)
+			}
+			return nil, mapIdempotencyError(err)
+		}
+		if stored != nil {
+			resp, err := decodeResponse(stored)
+			if err != nil {
+				logger.Log.Infof("grpc idempotency: decode error method=%s err=%v", info.FullMethod, err)
+				return nil, status.Error(codes.Internal, "internal error")
+			}
+			return resp, nil
+		}
+
+		release := func() {
+			if err := svc.Release(ctx, reservation); err != nil {
+				logger.Log.Infof("grpc idempotency: release error method=%s err=%v", info.FullMethod, err)
+			}
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			release()
+			return resp, err
+		}
+
+		// A response that cannot be stored frees the key, so that retries
+		// are not refused until the lease runs out.
+		encoded, err := encodeResponse(resp)
+		if err != nil {
+			logger.Log.Infof("grpc idempotency: encode error method=%s err=%v", info.FullMethod, err)
+			release()
+			return resp, nil
+		}
+		if err := svc.Complete(ctx, reservation, encoded); err != nil {
+			logger.Log.Infof("grpc idempotency: complete error method=%s err=%v", info.FullMethod, err)
+			release()
+		}
+		return resp, nil
+	}
+}
+
+// requestHash fingerprints the request without its token, so a retry with a
+// refreshed token still matches. The expected version sent as metadata is
+// part of the request, so a retry with a different one does not match.
+func requestHash(ctx context.Context, msg authenticatedRequest) (string, error) {
+	clone := proto.Clone(msg)
+	reflected := clone.ProtoReflect()
+	if field := reflected.Descriptor().Fields().ByName("jwt"); field != nil {
+		reflected.Clear(field)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	hash.Write(data)
+	for _, version := range metadata.ValueFromIncomingContext(ctx, expectedVersionKey) {
+		hash.Write([]byte{0})
+		hash.Write([]byte(version))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func encodeResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, errors.New("response is not a proto message")
+	}
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(wrapped)
+}
+
+func decodeResponse(data []byte) (proto.Message, error) {
+	wrapped := &anypb.Any{}
+	if err := proto.Unmarshal(data, wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.UnmarshalNew()
+}
+
+func mapIdempotencyError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidIdempotencyKey), errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrIdempotencyInProgress):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -0,0 +1,269 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/internal/task/usecase"
+	"task-tracker/pkg/logger"
+)
+
+type ProjectHandler struct {
+	taskpb.UnimplementedProjectServiceServer
+	svc *usecase.ProjectService
+}
+
+func NewProjectHandler(svc *usecase.ProjectService) *ProjectHandler {
+	return &ProjectHandler{svc: svc}
+}
+
+func (h *ProjectHandler) CreateProject(ctx context.Context, req *taskpb.CreateProjectRequest) (*taskpb.ProjectResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc create project: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	draft := domain.Project{
+		Name:  req.GetName(),
+		Color: req.GetColor(),
+	}
+	project, err := h.svc.Create(ctx, req.GetJwt(), draft)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ProjectResponse{Project: toProtoProject(project)}, nil
+}
+
+func (h *ProjectHandler) GetProject(ctx context.Context, req *taskpb.GetProjectRequest) (*taskpb.ProjectResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get project: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	project, err := h.svc.GetByID(ctx, req.GetJwt(), req.GetId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ProjectResponse{Project: toProtoProject(project)}, nil
+}
+
+func (h *ProjectHandler) ListProjects(ctx context.Context, req *taskpb.ListProjectsRequest) (*taskpb.ProjectsResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list projects: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	projects, err := h.svc.List(ctx, req.GetJwt(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.ProjectsResponse{Projects: make([]*taskpb.Project, 0, len(projects))}
+	for _, project := range projects {
+		resp.Projects = append(resp.Projects, toProtoProject(project))
+	}
+	return resp, nil
+}
+
+func (h *ProjectHandler) UpdateProject(ctx context.Context, req *taskpb.UpdateProjectRequest) (*taskpb.ProjectResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc update project: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	update, err := toDomainProjectUpdate(req)
+	if err != nil {
+		logger.Log.Infof("grpc update project: invalid update err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	project, err := h.svc.Update(ctx, req.GetJwt(), req.GetId(), update)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ProjectResponse{Project: toProtoProject(project)}, nil
+}
+
+func (h *ProjectHandler) DeleteProject(ctx context.Context, req *taskpb.DeleteProjectRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete project: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.Delete(ctx, req.GetJwt(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ProjectHandler) ListProjectMembers(ctx context.Context, req *taskpb.ListProjectMembersRequest) (*taskpb.ProjectMembersResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list project members: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	members, err := h.svc.ListMembers(ctx, req.GetJwt(), req.GetProjectId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.ProjectMembersResponse{Members: make([]*taskpb.ProjectMember, 0, len(members))}
+	for _, member := range members {
+		resp.Members = append(resp.Members, toProtoMember(member))
+	}
+	return resp, nil
+}
+
+func (h *ProjectHandler) AddProjectMember(ctx context.Context, req *taskpb.AddProjectMemberRequest) (*taskpb.ProjectMemberResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc add project member: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	role, err := toDomainRole(req.GetRole())
+	if err != nil {
+		logger.Log.Infof("grpc add project member: invalid role err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := h.svc.AddMember(ctx, req.GetJwt(), req.GetProjectId(), req.GetEmail(), role)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ProjectMemberResponse{Member: toProtoMember(member)}, nil
+}
+
+func (h *ProjectHandler) UpdateProjectMember(ctx context.Context, req *taskpb.UpdateProjectMemberRequest) (*taskpb.ProjectMemberResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc update project member: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	role, err := toDomainRole(req.GetRole())
+	if err != nil {
+		logger.Log.Infof("grpc update project member: invalid role err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	member, err := h.svc.UpdateMemberRole(ctx, req.GetJwt(), req.GetProjectId(), req.GetUserId(), role)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.ProjectMemberResponse{Member: toProtoMember(member)}, nil
+}
+
+func (h *ProjectHandler) RemoveProjectMember(ctx context.Context, req *taskpb.RemoveProjectMemberRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc remove project member: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.RemoveMember(ctx, req.GetJwt(), req.GetProjectId(), req.GetUserId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toDomainProjectUpdate(req *taskpb.UpdateProjectRequest) (domain.ProjectUpdate, error) {
+	var update domain.ProjectUpdate
+	setName := func() {
+		name := req.GetName()
+		update.Name = &name
+	}
+	setColor := func() {
+		color := req.GetColor()
+		update.Color = &color
+	}
+	setArchived := func() {
+		archived := req.GetArchived()
+		update.Archived = &archived
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.GetName() != "" {
+			setName()
+		}
+		if req.GetColor() != "" {
+			setColor()
+		}
+		if req.GetArchived() {
+			setArchived()
+		}
+		return update, nil
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			setName()
+		case "color":
+			setColor()
+		case "archived":
+			setArchived()
+		default:
+			return domain.ProjectUpdate{}, fmt.Errorf("unknown update mask path %q", path)
+		}
+	}
+	return update, nil
+}
+
+func toProtoProject(project domain.Project) *taskpb.Project {
+	return &taskpb.Project{
+		Id:        project.ID,
+		UserId:    project.UserID,
+		Name:      project.Name,
+		Color:     project.Color,
+		Archived:  project.Archived,
+		CreatedAt: project.CreatedAt.Unix(),
+		Role:      toProtoRole(project.Role),
+		Counts: &taskpb.ProjectCounts{
+			Total:     int32(project.Counts.Total),
+			Open:      int32(project.Counts.Open),
+			Completed: int32(project.Counts.Completed),
+			Expired:   int32(project.Counts.Expired),
+		},
+	}
+}
+
+func toProtoMember(member domain.ProjectMember) *taskpb.ProjectMember {
+	return &taskpb.ProjectMember{
+		UserId:    member.UserID,
+		Email:     member.Email,
+		Role:      toProtoRole(member.Role),
+		CreatedAt: member.CreatedAt.Unix(),
+	}
+}
+
+func toDomainRole(role taskpb.ProjectRole) (domain.ProjectRole, error) {
+	switch role {
+	case taskpb.ProjectRole_PROJECT_ROLE_OWNER:
+		return domain.OWNER, nil
+	case taskpb.ProjectRole_PROJECT_ROLE_EDITOR:
+		return domain.EDITOR, nil
+	case taskpb.ProjectRole_PROJECT_ROLE_VIEWER:
+		return domain.VIEWER, nil
+	default:
+		return domain.VIEWER, errors.New("unknown role")
+	}
+}
+
+func toProtoRole(role domain.ProjectRole) taskpb.ProjectRole {
+	switch role {
+	case domain.OWNER:
+		return taskpb.ProjectRole_PROJECT_ROLE_OWNER
+	case domain.EDITOR:
+		return taskpb.ProjectRole_PROJECT_ROLE_EDITOR
+	case domain.VIEWER:
+		return taskpb.ProjectRole_PROJECT_ROLE_VIEWER
+	default:
+		return taskpb.ProjectRole_PROJECT_ROLE_UNSPECIFIED
+	}
+}
@@ -16,11 +16,13 @@ import (
 
 type SchedulerHandler struct {
 	schedulerpb.UnimplementedSchedulerServiceServer
-	svc *usecase.TaskService
+	svc         *usecase.TaskService
+	idempotency *usecase.IdempotencyService
+	attachments *usecase.AttachmentService
 }
 
-func NewSchedulerHandler(svc *usecase.TaskService) *SchedulerHandler {
-	return &SchedulerHandler{svc: svc}
+func NewSchedulerHandler(svc *usecase.TaskService, idempotency *usecase.IdempotencyService, attachments *usecase.AttachmentService) *SchedulerHandler {
+	return &SchedulerHandler{svc: svc, idempotency: idempotency, attachments: attachments}
 }
 
 func (h *SchedulerHandler) ProcessRecentExpired(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
@@ -32,6 +34,51 @@ func (h *SchedulerHandler) ProcessRecentExpired(ctx context.Context, _ *emptypb.
 	return &emptypb.Empty{}, nil
 }
 
+func (h *SchedulerHandler) MaterializeRecurring(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := h.svc.MaterializeRecurring(ctx); err != nil {
+		logger.Log.Infof("grpc materialize recurring: err=%v", err)
+		return nil, mapSchedulerError(err)
+	}
+	logger.Log.Infof("grpc materialize recurring: ok")
+	return &emptypb.Empty{}, nil
+}
+
+func (h *SchedulerHandler) SendDueReminders(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := h.svc.SendDueReminders(ctx); err != nil {
+		logger.Log.Infof("grpc send due reminders: err=%v", err)
+		return nil, mapSchedulerError(err)
+	}
+	logger.Log.Infof("grpc send due reminders: ok")
+	return &emptypb.Empty{}, nil
+}
+
+func (h *SchedulerHandler) PurgeTrash(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := h.svc.PurgeTrash(ctx); err != nil {
+		logger.Log.Infof("grpc purge trash: err=%v", err)
+		return nil, mapSchedulerError(err)
+	}
+	logger.Log.Infof("grpc purge trash: ok")
+	return &emptypb.Empty{}, nil
+}
+
+func (h *SchedulerHandler) PurgeIdempotencyKeys(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := h.idempotency.PurgeExpired(ctx); err != nil {
+		logger.Log.Infof("grpc purge idempotency keys: err=%v", err)
+		return nil, mapSchedulerError(err)
+	}
+	logger.Log.Infof("grpc purge idempotency keys: ok")
+	return &emptypb.Empty{}, nil
+}
+
+func (h *SchedulerHandler) PurgeAttachments(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := h.attachments.PurgeOrphaned(ctx); err != nil {
+		logger.Log.Infof("grpc purge attachments: err=%v", err)
+		return nil, mapSchedulerError(err)
+	}
+	logger.Log.Infof("grpc purge attachments: ok")
+	return &emptypb.Empty{}, nil
+}
+
 func mapSchedulerError(err error) error {
 	switch {
 	case err == nil:
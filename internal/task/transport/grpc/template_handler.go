@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) CreateTaskTemplate(ctx context.Context, req *taskpb.CreateTaskTemplateRequest) (*taskpb.TaskTemplateResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc create task template: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	items, err := toDomainTemplateItems(req.GetItems())
+	if err != nil {
+		logger.Log.Infof("grpc create task template: invalid items err=%v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template, err := h.svc.CreateTemplate(ctx, req.GetJwt(), req.GetName(), items)
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskTemplateResponse{Template: toProtoTemplate(template)}, nil
+}
+
+func (h *TaskHandler) SaveTaskTemplate(ctx context.Context, req *taskpb.SaveTaskTemplateRequest) (*taskpb.TaskTemplateResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc save task template: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	template, err := h.svc.SaveAsTemplate(ctx, req.GetJwt(), req.GetName(), req.GetTaskId(), req.GetProjectId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskTemplateResponse{Template: toProtoTemplate(template)}, nil
+}
+
+func (h *TaskHandler) ListTaskTemplates(ctx context.Context, req *taskpb.ListTaskTemplatesRequest) (*taskpb.TaskTemplatesResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list task templates: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	templates, err := h.svc.ListTemplates(ctx, req.GetJwt())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.TaskTemplatesResponse{Templates: make([]*taskpb.TaskTemplate, 0, len(templates))}
+	for _, template := range templates {
+		resp.Templates = append(resp.Templates, toProtoTemplate(template))
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) GetTaskTemplate(ctx context.Context, req *taskpb.GetTaskTemplateRequest) (*taskpb.TaskTemplateResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get task template: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	template, err := h.svc.GetTemplate(ctx, req.GetJwt(), req.GetId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TaskTemplateResponse{Template: toProtoTemplate(template)}, nil
+}
+
+func (h *TaskHandler) DeleteTaskTemplate(ctx context.Context, req *taskpb.DeleteTaskTemplateRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete task template: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.DeleteTemplate(ctx, req.GetJwt(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TaskHandler) InstantiateTaskTemplate(ctx context.Context, req *taskpb.InstantiateTaskTemplateRequest) (*taskpb.TasksResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc instantiate task template: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tasks, err := h.svc.InstantiateTemplate(ctx, req.GetJwt(), req.GetId(), req.GetStartDay(), req.GetProjectId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TasksResponse{Tasks: toProtoTasks(tasks)}, nil
+}
+
+func toDomainTemplateItems(protoItems []*taskpb.TemplateItem) ([]domain.TemplateItem, error) {
+	items := make([]domain.TemplateItem, 0, len(protoItems))
+	for _, protoItem := range protoItems {
+		priority, err := toDomainPriority(protoItem.GetPriority())
+		if err != nil {
+			return nil, err
+		}
+		subtasks, err := toDomainTemplateItems(protoItem.GetSubtasks())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, domain.TemplateItem{
+			Description:   protoItem.GetDescription(),
+			DueOffsetDays: int(protoItem.GetDueOffsetDays()),
+			Priority:      priority,
+			Tags:          protoItem.GetTags(),
+			Subtasks:      subtasks,
+		})
+	}
+	return items, nil
+}
+
+func toProtoTemplate(template domain.TaskTemplate) *taskpb.TaskTemplate {
+	return &taskpb.TaskTemplate{
+		Id:        template.ID,
+		Name:      template.Name,
+		Items:     toProtoTemplateItems(template.Items),
+		ItemCount: int32(template.ItemCount),
+		CreatedAt: template.CreatedAt.Unix(),
+	}
+}
+
+func toProtoTemplateItems(items []domain.TemplateItem) []*taskpb.TemplateItem {
+	protoItems := make([]*taskpb.TemplateItem, 0, len(items))
+	for _, item := range items {
+		protoItems = append(protoItems, &taskpb.TemplateItem{
+			Description:   item.Description,
+			DueOffsetDays: int32(item.DueOffsetDays),
+			Priority:      toProtoPriority(item.Priority),
+			Tags:          item.Tags,
+			Subtasks:      toProtoTemplateItems(item.Subtasks),
+		})
+	}
+	return protoItems
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) StartTimer(ctx context.Context, req *taskpb.StartTimerRequest) (*taskpb.TimeEntryResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc start timer: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	entry, err := h.svc.StartTimer(ctx, req.GetJwt(), req.GetTaskId(), req.GetNote())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TimeEntryResponse{Entry: toProtoTimeEntry(entry)}, nil
+}
+
+func (h *TaskHandler) StopTimer(ctx context.Context, req *taskpb.StopTimerRequest) (*taskpb.TimeEntryResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc stop timer: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	entry, err := h.svc.StopTimer(ctx, req.GetJwt(), req.GetTaskId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TimeEntryResponse{Entry: toProtoTimeEntry(entry)}, nil
+}
+
+func (h *TaskHandler) GetRunningTimer(ctx context.Context, req *taskpb.GetRunningTimerRequest) (*taskpb.TimeEntryResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get running timer: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	entry, err := h.svc.GetRunningTimer(ctx, req.GetJwt())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TimeEntryResponse{Entry: toProtoTimeEntry(entry)}, nil
+}
+
+func (h *TaskHandler) ListTimeEntries(ctx context.Context, req *taskpb.ListTimeEntriesRequest) (*taskpb.TimeEntriesResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc list time entries: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tracked, err := h.svc.ListTimeEntries(ctx, req.GetJwt(), req.GetTaskId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.TimeEntriesResponse{
+		Entries:      make([]*taskpb.TimeEntry, 0, len(tracked.Entries)),
+		Days:         make([]*taskpb.DailyTime, 0, len(tracked.Days)),
+		TotalSeconds: toSeconds(tracked.Total),
+	}
+	for _, entry := range tracked.Entries {
+		resp.Entries = append(resp.Entries, toProtoTimeEntry(entry))
+	}
+	for _, day := range tracked.Days {
+		resp.Days = append(resp.Days, &taskpb.DailyTime{Day: day.Day, DurationSeconds: toSeconds(day.Duration)})
+	}
+	return resp, nil
+}
+
+func (h *TaskHandler) AddTimeEntry(ctx context.Context, req *taskpb.AddTimeEntryRequest) (*taskpb.TimeEntryResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc add time entry: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	entry, err := h.svc.AddTimeEntry(ctx, req.GetJwt(), req.GetTaskId(), fromUnix(req.GetStartedAt()), fromUnix(req.GetEndedAt()), req.GetNote())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &taskpb.TimeEntryResponse{Entry: toProtoTimeEntry(entry)}, nil
+}
+
+func (h *TaskHandler) DeleteTimeEntry(ctx context.Context, req *taskpb.DeleteTimeEntryRequest) (*emptypb.Empty, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc delete time entry: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	if err := h.svc.DeleteTimeEntry(ctx, req.GetJwt(), req.GetTaskId(), req.GetId()); err != nil {
+		return nil, mapTaskError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TaskHandler) GetTimeReport(ctx context.Context, req *taskpb.GetTimeReportRequest) (*taskpb.TimeReportResponse, error) {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc get time report: missing token")
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	report, err := h.svc.TimeReport(ctx, req.GetJwt(), req.GetFromDay(), req.GetToDay(), req.GetProjectId())
+	if err != nil {
+		return nil, mapTaskError(err)
+	}
+
+	resp := &taskpb.TimeReportResponse{
+		Rows:         make([]*taskpb.ProjectDailyTime, 0, len(report.Rows)),
+		Projects:     make([]*taskpb.ProjectTime, 0, len(report.Projects)),
+		TotalSeconds: toSeconds(report.Total),
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, &taskpb.ProjectDailyTime{ProjectId: row.ProjectID, Day: row.Day, DurationSeconds: toSeconds(row.Duration)})
+	}
+	for _, project := range report.Projects {
+		resp.Projects = append(resp.Projects, &taskpb.ProjectTime{ProjectId: project.ProjectID, DurationSeconds: toSeconds(project.Duration)})
+	}
+	return resp, nil
+}
+
+func toProtoTimeEntry(entry domain.TimeEntry) *taskpb.TimeEntry {
+	return &taskpb.TimeEntry{
+		Id:              entry.ID,
+		TaskId:          entry.TaskID,
+		UserId:          entry.UserID,
+		StartedAt:       entry.StartedAt.Unix(),
+		EndedAt:         toUnix(entry.EndedAt),
+		Note:            entry.Note,
+		Manual:          entry.Manual,
+		DurationSeconds: toSeconds(entry.Duration(time.Now())),
+	}
+}
+
+func toSeconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
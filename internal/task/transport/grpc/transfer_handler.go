@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskpb "task-tracker/gen/public/task"
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (h *TaskHandler) ExportTasks(req *taskpb.ExportTasksRequest, stream taskpb.TaskService_ExportTasksServer) error {
+	if req.GetJwt() == "" {
+		logger.Log.Infof("grpc export tasks: missing token")
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	format := toDomainTransferFormat(req.GetFormat())
+	writer := &exportWriter{stream: tasksExportStream{stream}, info: exportInfo(format)}
+	if _, err := h.svc.ExportTasks(stream.Context(), req.GetJwt(), format, req.GetProjectId(), writer); err != nil {
+		return mapTaskError(err)
+	}
+	return writer.Close()
+}
+
+func (h *TaskHandler) GetCalendarFeed(req *taskpb.GetCalendarFeedRequest, stream taskpb.TaskService_GetCalendarFeedServer) error {
+	if req.GetToken() == "" {
+		logger.Log.Infof("grpc get calendar feed: missing token")
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	writer := &exportWriter{stream: calendarFeedStream{stream}, info: &taskpb.ExportInfo{FileName: "tasks.ics", ContentType: "text/calendar; charset=utf-8"}}
+	if _, err := h.calendar.Feed(stream.Context(), req.GetToken(), writer); err != nil {
+		return mapTaskError(err)
+	}
+	return writer.Close()
+}
+
+func (h *TaskHandler) ImportTasks(stream taskpb.TaskService_ImportTasksServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Log.Infof("grpc import tasks: recv error err=%v", err)
+		return status.Error(codes.InvalidArgument, "missing import info")
+	}
+	info := first.GetInfo()
+	if info == nil {
+		logger.Log.Infof("grpc import tasks: missing import info")
+		return status.Error(codes.InvalidArgument, "missing import info")
+	}
+	if info.GetJwt() == "" {
+		logger.Log.Infof("grpc import tasks: missing token")
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	report, err := h.svc.ImportTasks(stream.Context(), info.GetJwt(), toDomainTransferFormat(info.GetFormat()), info.GetDryRun(), &importReader{stream: stream})
+	if err != nil {
+		return mapTaskError(err)
+	}
+
+	resp := &taskpb.ImportTasksResponse{
+		DryRun:     report.DryRun,
+		Created:    int32(report.Created),
+		Duplicates: int32(report.Duplicates),
+		Invalid:    int32(report.Invalid),
+		Rows:       make([]*taskpb.ImportRowResult, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, &taskpb.ImportRowResult{
+			Line:   int32(row.Line),
+			Status: toProtoImportRowStatus(row.Status),
+			TaskId: row.TaskID,
+			Error:  row.Error,
+		})
+	}
+	return stream.SendAndClose(resp)
+}
+
+func exportInfo(format domain.TransferFormat) *taskpb.ExportInfo {
+	name := "tasks-" + time.Now().UTC().Format("2006-01-02")
+	if format == domain.FORMAT_JSONL {
+		return &taskpb.ExportInfo{FileName: name + ".jsonl", ContentType: "application/x-ndjson"}
+	}
+	return &taskpb.ExportInfo{FileName: name + ".csv", ContentType: "text/csv; charset=utf-8"}
+}
+
+func toDomainTransferFormat(format taskpb.TransferFormat) domain.TransferFormat {
+	switch format {
+	case taskpb.TransferFormat_TRANSFER_FORMAT_CSV:
+		return domain.FORMAT_CSV
+	case taskpb.TransferFormat_TRANSFER_FORMAT_JSONL:
+		return domain.FORMAT_JSONL
+	default:
+		return 0
+	}
+}
+
+func toProtoImportRowStatus(rowStatus domain.ImportRowStatus) taskpb.ImportRowStatus {
+	switch rowStatus {
+	case domain.IMPORT_CREATED:
+		return taskpb.ImportRowStatus_IMPORT_ROW_STATUS_CREATED
+	case domain.IMPORT_DUPLICATE:
+		return taskpb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE
+	case domain.IMPORT_INVALID:
+		return taskpb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID
+	default:
+		return taskpb.ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+	}
+}
+
+// exportStream is the server side of ExportTasks and GetCalendarFeed, which
+// share the file framing: the file info first, then the content in chunks.
+type exportStream interface {
+	SendInfo(info *taskpb.ExportInfo) error
+	SendChunk(chunk []byte) error
+}
+
+type tasksExportStream struct {
+	stream taskpb.TaskService_ExportTasksServer
+}
+
+func (s tasksExportStream) SendInfo(info *taskpb.ExportInfo) error {
+	return s.stream.Send(&taskpb.ExportTasksResponse{Payload: &taskpb.ExportTasksResponse_Info{Info: info}})
+}
+
+func (s tasksExportStream) SendChunk(chunk []byte) error {
+	return s.stream.Send(&taskpb.ExportTasksResponse{Payload: &taskpb.ExportTasksResponse_Chunk{Chunk: chunk}})
+}
+
+type calendarFeedStream struct {
+	stream taskpb.TaskService_GetCalendarFeedServer
+}
+
+func (s calendarFeedStream) SendInfo(info *taskpb.ExportInfo) error {
+	return s.stream.Send(&taskpb.GetCalendarFeedResponse{Payload: &taskpb.GetCalendarFeedResponse_Info{Info: info}})
+}
+
+func (s calendarFeedStream) SendChunk(chunk []byte) error {
+	return s.stream.Send(&taskpb.GetCalendarFeedResponse{Payload: &taskpb.GetCalendarFeedResponse_Chunk{Chunk: chunk}})
+}
+
+// exportWriter sends what is written to it as chunks of an export stream.
+// The file info goes out with the first chunk, so an export that fails
+// before writing anything still ends with a plain status error.
+type exportWriter struct {
+	stream exportStream
+	info   *taskpb.ExportInfo
+	buf    []byte
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= attachmentChunkSize {
+		if err := w.send(w.buf[:attachmentChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[attachmentChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Close sends the remaining buffered content, and the file info if the
+// export was empty.
+func (w *exportWriter) Close() error {
+	if w.info != nil || len(w.buf) > 0 {
+		if err := w.send(w.buf); err != nil {
+			return err
+		}
+	}
+	w.buf = nil
+	return nil
+}
+
+func (w *exportWriter) send(chunk []byte) error {
+	if w.info != nil {
+		if err := w.stream.SendInfo(w.info); err != nil {
+			return err
+		}
+		w.info = nil
+	}
+	if len(chunk) == 0 {
+		return nil
+	}
+	return w.stream.SendChunk(chunk)
+}
+
+// importReader exposes the chunks of an import stream as an io.Reader.
+type importReader struct {
+	stream taskpb.TaskService_ImportTasksServer
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 
+	"task-tracker/internal/task/domain"
 	"task-tracker/internal/task/usecase"
 	"task-tracker/pkg/logger"
 )
@@ -18,26 +20,63 @@ type ExpiredSummaryMessage struct {
 }
 
 type UserSummaryMessage struct {
-	UserID       int64 `json:"user_id"`
-	Completed    int   `json:"completed"`
-	NotCompleted int   `json:"not_completed"`
+	UserID       int64                    `json:"user_id"`
+	Completed    int                      `json:"completed"`
+	NotCompleted int                      `json:"not_completed"`
+	ByPriority   []PrioritySummaryMessage `json:"by_priority,omitempty"`
+}
+
+type PrioritySummaryMessage struct {
+	Priority     string `json:"priority"`
+	Completed    int    `json:"completed"`
+	NotCompleted int    `json:"not_completed"`
+}
+
+type ReminderMessage struct {
+	TaskID        int64  `json:"task_id"`
+	UserID        int64  `json:"user_id"`
+	Description   string `json:"description"`
+	DueDate       int64  `json:"due_date"`
+	OffsetMinutes int64  `json:"offset_minutes"`
+	RemindAt      int64  `json:"remind_at"`
+}
+
+type BlockerCompletedMessage struct {
+	TaskID             int64  `json:"task_id"`
+	UserID             int64  `json:"user_id"`
+	AssigneeID         int64  `json:"assignee_id,omitempty"`
+	Description        string `json:"description"`
+	BlockerID          int64  `json:"blocker_id"`
+	BlockerDescription string `json:"blocker_description"`
+	Remaining          int    `json:"remaining"`
 }
 
 type Publisher struct {
-	writer *kafka.Writer
+	writer           *kafka.Writer
+	reminderWriter   *kafka.Writer
+	dependencyWriter *kafka.Writer
 }
 
-func NewPublisher(writer *kafka.Writer) *Publisher {
-	return &Publisher{writer: writer}
+func NewPublisher(writer, reminderWriter, dependencyWriter *kafka.Writer) *Publisher {
+	return &Publisher{writer: writer, reminderWriter: reminderWriter, dependencyWriter: dependencyWriter}
 }
 
 func (p *Publisher) PublishExpiredSummary(ctx context.Context, summary usecase.ExpiredSummary) error {
 	users := make([]UserSummaryMessage, 0, len(summary.Users))
 	for _, user := range summary.Users {
+		byPriority := make([]PrioritySummaryMessage, 0, len(user.ByPriority))
+		for _, priority := range user.ByPriority {
+			byPriority = append(byPriority, PrioritySummaryMessage{
+				Priority:     priority.Priority.String(),
+				Completed:    priority.Completed,
+				NotCompleted: priority.NotCompleted,
+			})
+		}
 		users = append(users, UserSummaryMessage{
 			UserID:       user.UserID,
 			Completed:    user.Completed,
 			NotCompleted: user.NotCompleted,
+			ByPriority:   byPriority,
 		})
 	}
 
@@ -63,3 +102,58 @@ func (p *Publisher) PublishExpiredSummary(ctx context.Context, summary usecase.E
 	logger.Log.Infof("kafka publish expired summary: success users=%d", len(payload.Users))
 	return nil
 }
+
+func (p *Publisher) PublishReminder(ctx context.Context, reminder domain.Reminder) error {
+	payload := ReminderMessage{
+		TaskID:        reminder.TaskID,
+		UserID:        reminder.UserID,
+		Description:   reminder.Description,
+		DueDate:       reminder.DueDate.Unix(),
+		OffsetMinutes: int64(reminder.Offset / time.Minute),
+		RemindAt:      reminder.RemindAt.Unix(),
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Log.Infof("kafka publish reminder: marshal error task_id=%d err=%v", reminder.TaskID, err)
+		return err
+	}
+
+	if err := p.reminderWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(payload.TaskID, 10)),
+		Value: data,
+	}); err != nil {
+		logger.Log.Infof("kafka publish reminder: write error task_id=%d err=%v", reminder.TaskID, err)
+		return err
+	}
+	logger.Log.Infof("kafka publish reminder: success task_id=%d offset_minutes=%d", payload.TaskID, payload.OffsetMinutes)
+	return nil
+}
+
+func (p *Publisher) PublishBlockerCompleted(ctx context.Context, event domain.BlockerCompleted) error {
+	payload := BlockerCompletedMessage{
+		TaskID:             event.TaskID,
+		UserID:             event.UserID,
+		AssigneeID:         event.AssigneeID,
+		Description:        event.Description,
+		BlockerID:          event.BlockerID,
+		BlockerDescription: event.BlockerDescription,
+		Remaining:          event.Remaining,
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Log.Infof("kafka publish blocker completed: marshal error task_id=%d err=%v", event.TaskID, err)
+		return err
+	}
+
+	if err := p.dependencyWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(payload.TaskID, 10)),
+		Value: data,
+	}); err != nil {
+		logger.Log.Infof("kafka publish blocker completed: write error task_id=%d err=%v", event.TaskID, err)
+		return err
+	}
+	logger.Log.Infof("kafka publish blocker completed: success task_id=%d blocker_id=%d remaining=%d", payload.TaskID, payload.BlockerID, payload.Remaining)
+	return nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"task-tracker/internal/task/domain"
+)
+
+type taskAccess int
+
+const (
+	accessRead taskAccess = iota
+	accessStatus
+	accessWrite
+)
+
+type UserDirectory interface {
+	GetUserIDByEmail(ctx context.Context, email string) (int64, error)
+	GetEmailsByIDs(ctx context.Context, ids []int64) (map[int64]string, error)
+}
+
+// authorizeTask loads a task on behalf of userID. Owners always pass; other
+// users need a membership in the task's project with a suitable role, and
+// assignees may change the status of their tasks.
+func (s *TaskService) authorizeTask(ctx context.Context, id, userID int64, access taskAccess) (domain.Task, error) {
+	task, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return s.checkAccess(ctx, task, userID, access)
+}
+
+func (s *TaskService) checkAccess(ctx context.Context, task domain.Task, userID int64, access taskAccess) (domain.Task, error) {
+	if task.UserID == userID {
+		return task, nil
+	}
+	if task.ProjectID == 0 {
+		return domain.Task{}, domain.ErrNotFound
+	}
+
+	project, err := s.projects.GetByIDAndMemberID(ctx, task.ProjectID, userID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	switch {
+	case project.Role.CanEdit(), access == accessRead:
+		return task, nil
+	case access == accessStatus && task.AssigneeID == userID:
+		return task, nil
+	default:
+		return domain.Task{}, domain.ErrForbidden
+	}
+}
+
+func (s *TaskService) checkProject(ctx context.Context, projectID, userID int64) error {
+	project, err := s.projects.GetByIDAndMemberID(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !project.Role.CanEdit() {
+		return domain.ErrForbidden
+	}
+	if project.Archived {
+		return domain.ErrProjectArchived
+	}
+	return nil
+}
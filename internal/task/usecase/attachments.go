@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/blob"
+	"task-tracker/pkg/logger"
+)
+
+const (
+	sniffLength        = 512
+	orphanedBatchLimit = 500
+)
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type AttachmentService struct {
+	tasks   *TaskService
+	repo    domain.AttachmentRepository
+	blobs   BlobStore
+	tokens  TokenParser
+	maxSize int64
+	now     func() time.Time
+}
+
+func NewAttachmentService(tasks *TaskService, repo domain.AttachmentRepository, blobs BlobStore, tokens TokenParser, maxSize int64) *AttachmentService {
+	return &AttachmentService{tasks: tasks, repo: repo, blobs: blobs, tokens: tokens, maxSize: maxSize, now: time.Now}
+}
+
+// Upload streams body into the blob store. The declared size must match the
+// number of bytes sent, and the content must sniff as the declared type.
+func (s *AttachmentService) Upload(ctx context.Context, token string, draft domain.Attachment, body io.Reader) (domain.Attachment, error) {
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(draft.FileName), `\`, "/"))
+	if draft.TaskID <= 0 || fileName == "" || fileName == "." || fileName == "/" || len(fileName) > domain.MaxAttachmentNameLength || draft.Size <= 0 {
+		logger.Log.Infof("task upload attachment: invalid input task_id=%d size=%d", draft.TaskID, draft.Size)
+		return domain.Attachment{}, ErrInvalidInput
+	}
+	if draft.Size > s.maxSize {
+		logger.Log.Infof("task upload attachment: too large task_id=%d size=%d", draft.TaskID, draft.Size)
+		return domain.Attachment{}, domain.ErrAttachmentTooLarge
+	}
+	contentType := mediaType(draft.ContentType)
+	if !domain.AttachmentContentTypeAllowed(contentType) {
+		logger.Log.Infof("task upload attachment: unsupported content type=%q", draft.ContentType)
+		return domain.Attachment{}, domain.ErrUnsupportedContentType
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task upload attachment: invalid token err=%v", err)
+		return domain.Attachment{}, ErrInvalidToken
+	}
+
+	if _, err := s.tasks.authorizeTask(ctx, draft.TaskID, userID, accessStatus); err != nil {
+		logger.Log.Infof("task upload attachment: access error task_id=%d user_id=%d err=%v", draft.TaskID, userID, err)
+		return domain.Attachment{}, err
+	}
+
+	reader := bufio.NewReaderSize(body, sniffLength)
+	head, err := reader.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Log.Infof("task upload attachment: read error task_id=%d err=%v", draft.TaskID, err)
+		return domain.Attachment{}, err
+	}
+	if detected := mediaType(http.DetectContentType(head)); detected != contentType {
+		logger.Log.Infof("task upload attachment: content mismatch declared=%s detected=%s", contentType, detected)
+		return domain.Attachment{}, domain.ErrUnsupportedContentType
+	}
+
+	key, err := attachmentKey(draft.TaskID)
+	if err != nil {
+		logger.Log.Infof("task upload attachment: key error err=%v", err)
+		return domain.Attachment{}, err
+	}
+	sized := &sizedReader{r: reader, remaining: draft.Size}
+	if err := s.blobs.Put(ctx, key, sized, draft.Size, contentType); err != nil {
+		if sized.short {
+			logger.Log.Infof("task upload attachment: short body task_id=%d size=%d", draft.TaskID, draft.Size)
+			return domain.Attachment{}, ErrInvalidInput
+		}
+		logger.Log.Infof("task upload attachment: store error task_id=%d err=%v", draft.TaskID, err)
+		return domain.Attachment{}, err
+	}
+	if n, _ := reader.Read(make([]byte, 1)); n > 0 {
+		logger.Log.Infof("task upload attachment: body exceeds size task_id=%d size=%d", draft.TaskID, draft.Size)
+		s.deleteBlob(ctx, key)
+		return domain.Attachment{}, ErrInvalidInput
+	}
+
+	attachment, err := s.repo.Create(ctx, domain.Attachment{
+		TaskID:      draft.TaskID,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        draft.Size,
+		StorageKey:  key,
+		CreatedAt:   s.now(),
+	})
+	if err != nil {
+		logger.Log.Infof("task upload attachment: repo error task_id=%d user_id=%d err=%v", draft.TaskID, userID, err)
+		s.deleteBlob(ctx, key)
+		return domain.Attachment{}, err
+	}
+	logger.Log.Infof("task upload attachment: success id=%d task_id=%d user_id=%d size=%d", attachment.ID, draft.TaskID, userID, draft.Size)
+	return attachment, nil
+}
+
+func (s *AttachmentService) List(ctx context.Context, token string, taskID int64) ([]domain.Attachment, error) {
+	if taskID <= 0 {
+		logger.Log.Infof("task list attachments: invalid task_id=%d", taskID)
+		return nil, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list attachments: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	if _, err := s.tasks.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task list attachments: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return nil, err
+	}
+	attachments, err := s.repo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		logger.Log.Infof("task list attachments: repo error task_id=%d err=%v", taskID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task list attachments: success task_id=%d user_id=%d count=%d", taskID, userID, len(attachments))
+	return attachments, nil
+}
+
+func (s *AttachmentService) Open(ctx context.Context, token string, taskID, id int64) (domain.Attachment, io.ReadCloser, error) {
+	if taskID <= 0 || id <= 0 {
+		logger.Log.Infof("task open attachment: invalid input task_id=%d id=%d", taskID, id)
+		return domain.Attachment{}, nil, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task open attachment: invalid token err=%v", err)
+		return domain.Attachment{}, nil, ErrInvalidToken
+	}
+
+	if _, err := s.tasks.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task open attachment: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.Attachment{}, nil, err
+	}
+	attachment, err := s.repo.GetByIDAndTaskID(ctx, id, taskID)
+	if err != nil {
+		logger.Log.Infof("task open attachment: repo error id=%d task_id=%d err=%v", id, taskID, err)
+		return domain.Attachment{}, nil, err
+	}
+	body, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		logger.Log.Infof("task open attachment: store error id=%d err=%v", id, err)
+		if errors.Is(err, blob.ErrNotFound) {
+			return domain.Attachment{}, nil, domain.ErrNotFound
+		}
+		return domain.Attachment{}, nil, err
+	}
+	logger.Log.Infof("task open attachment: success id=%d task_id=%d user_id=%d", id, taskID, userID)
+	return attachment, body, nil
+}
+
+// Delete removes an attachment. Uploaders may delete their own files; anyone
+// else needs write access to the task.
+func (s *AttachmentService) Delete(ctx context.Context, token string, taskID, id int64) error {
+	if taskID <= 0 || id <= 0 {
+		logger.Log.Infof("task delete attachment: invalid input task_id=%d id=%d", taskID, id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task delete attachment: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+
+	task, err := s.tasks.authorizeTask(ctx, taskID, userID, accessRead)
+	if err != nil {
+		logger.Log.Infof("task delete attachment: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return err
+	}
+	attachment, err := s.repo.GetByIDAndTaskID(ctx, id, taskID)
+	if err != nil {
+		logger.Log.Infof("task delete attachment: repo error id=%d task_id=%d err=%v", id, taskID, err)
+		return err
+	}
+	if attachment.UserID != userID {
+		if _, err := s.tasks.checkAccess(ctx, task, userID, accessWrite); err != nil {
+			logger.Log.Infof("task delete attachment: access error id=%d user_id=%d err=%v", id, userID, err)
+			return err
+		}
+	}
+
+	if err := s.repo.DeleteByID(ctx, id); err != nil {
+		logger.Log.Infof("task delete attachment: repo error id=%d err=%v", id, err)
+		return err
+	}
+	s.deleteBlob(ctx, attachment.StorageKey)
+	logger.Log.Infof("task delete attachment: success id=%d task_id=%d user_id=%d", id, taskID, userID)
+	return nil
+}
+
+// PurgeOrphaned removes the blobs and rows of attachments whose task has been
+// purged from the trash.
+func (s *AttachmentService) PurgeOrphaned(ctx context.Context) error {
+	attachments, err := s.repo.GetOrphaned(ctx, orphanedBatchLimit)
+	if err != nil {
+		logger.Log.Infof("task purge attachments: repo error err=%v", err)
+		return err
+	}
+
+	purged := 0
+	for _, attachment := range attachments {
+		if err := s.blobs.Delete(ctx, attachment.StorageKey); err != nil {
+			logger.Log.Infof("task purge attachments: store error id=%d err=%v", attachment.ID, err)
+			continue
+		}
+		if err := s.repo.DeleteByID(ctx, attachment.ID); err != nil {
+			logger.Log.Infof("task purge attachments: repo error id=%d err=%v", attachment.ID, err)
+			return err
+		}
+		purged++
+	}
+	logger.Log.Infof("task purge attachments: success count=%d", purged)
+	return nil
+}
+
+func (s *AttachmentService) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobs.Delete(ctx, key); err != nil {
+		logger.Log.Infof("task attachment: delete blob error key=%s err=%v", key, err)
+	}
+}
+
+func attachmentKey(taskID int64) (string, error) {
+	var suffix [16]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("tasks/%d/%s", taskID, hex.EncodeToString(suffix[:])), nil
+}
+
+func mediaType(contentType string) string {
+	value, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// sizedReader yields at most remaining bytes and records whether the
+// underlying reader ended early.
+type sizedReader struct {
+	r         io.Reader
+	remaining int64
+	short     bool
+}
+
+func (r *sizedReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.r.Read(p)
+	r.remaining -= int64(n)
+	if errors.Is(err, io.EOF) && r.remaining > 0 {
+		r.short = true
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// BatchUpdate applies update to the tasks listed in ids or, when filter is
+// set, to every task it matches. In BATCH_ALL_OR_NOTHING mode the first
+// failure rolls back the whole batch and every other item reports
+// ErrBatchAborted, and the side effects of its status changes, like timers
+// and the next occurrence of a series, run only after the commit. In
+// BATCH_BEST_EFFORT mode each task is changed on its own.
+func (s *TaskService) BatchUpdate(ctx context.Context, token string, ids []int64, filter *domain.TaskFilter, update domain.BatchUpdate, mode domain.BatchMode) ([]domain.BatchResult, error) {
+	if (len(ids) == 0) == (filter == nil) || len(ids) > domain.MaxBatchSize {
+		logger.Log.Infof("task batch update: invalid selection ids=%d filter=%t", len(ids), filter != nil)
+		return nil, ErrInvalidInput
+	}
+	if mode != domain.BATCH_ALL_OR_NOTHING && mode != domain.BATCH_BEST_EFFORT {
+		logger.Log.Infof("task batch update: invalid mode=%v", mode)
+		return nil, ErrInvalidInput
+	}
+	if err := validateBatchUpdate(update); err != nil {
+		logger.Log.Infof("task batch update: invalid update action=%v", update.Action)
+		return nil, err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task batch update: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	if filter != nil {
+		ids, err = s.batchTargets(ctx, userID, *filter)
+		if err != nil {
+			logger.Log.Infof("task batch update: filter error user_id=%d err=%v", userID, err)
+			return nil, err
+		}
+	} else if ids, err = uniqueIDs(ids); err != nil {
+		logger.Log.Infof("task batch update: invalid ids user_id=%d", userID)
+		return nil, err
+	}
+	if len(ids) == 0 {
+		logger.Log.Infof("task batch update: nothing selected user_id=%d", userID)
+		return []domain.BatchResult{}, nil
+	}
+
+	order, deletedWith, err := s.batchOrder(ctx, ids, update)
+	if err != nil {
+		logger.Log.Infof("task batch update: order error user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+
+	results := make([]domain.BatchResult, len(ids))
+	apply := func(ctx context.Context, i int) error {
+		if ancestor, ok := deletedWith[i]; ok && results[ancestor].Err == nil {
+			results[i] = domain.BatchResult{ID: ids[i]}
+			return nil
+		}
+		task, err := s.applyBatchItem(ctx, token, ids[i], update)
+		results[i] = domain.BatchResult{ID: ids[i], Task: task, Err: err}
+		return err
+	}
+
+	if mode == domain.BATCH_BEST_EFFORT {
+		failed := 0
+		for _, i := range order {
+			if apply(ctx, i) != nil {
+				failed++
+			}
+		}
+		logger.Log.Infof("task batch update: done user_id=%d action=%v count=%d failed=%d", userID, update.Action, len(ids), failed)
+		return results, nil
+	}
+
+	failed := -1
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+	txCtx, effects := deferSideEffects(ctx)
+	err = s.repo.InTx(txCtx, func(ctx context.Context) error {
+		for _, i := range order {
+			if err := apply(ctx, i); err != nil {
+				failed = i
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if failed < 0 {
+			logger.Log.Infof("task batch update: repo error user_id=%d err=%v", userID, err)
+			return nil, err
+		}
+		for i := range results {
+			if i != failed {
+				results[i] = domain.BatchResult{ID: ids[i], Err: domain.ErrBatchAborted}
+			}
+		}
+		logger.Log.Infof("task batch update: aborted user_id=%d action=%v id=%d err=%v", userID, update.Action, ids[failed], err)
+		return results, nil
+	}
+	effects.run(ctx)
+	logger.Log.Infof("task batch update: success user_id=%d action=%v count=%d", userID, update.Action, len(ids))
+	return results, nil
+}
+
+func (s *TaskService) applyBatchItem(ctx context.Context, token string, id int64, update domain.BatchUpdate) (domain.Task, error) {
+	switch update.Action {
+	case domain.BATCH_SET_STATUS:
+		return s.UpdateStatus(ctx, token, id, 0, update.Status)
+	case domain.BATCH_SET_DUE_DATE:
+		return s.Update(ctx, token, id, 0, domain.TaskUpdate{DueDate: &update.DueDate})
+	case domain.BATCH_MOVE_TO_PROJECT:
+		return s.MoveToProject(ctx, token, id, 0, update.ProjectID)
+	case domain.BATCH_SET_TAGS:
+		return s.Update(ctx, token, id, 0, domain.TaskUpdate{Tags: &update.Tags})
+	case domain.BATCH_DELETE:
+		return domain.Task{}, s.Delete(ctx, token, id, 0)
+	default:
+		return domain.Task{}, ErrInvalidInput
+	}
+}
+
+func (s *TaskService) batchTargets(ctx context.Context, userID int64, filter domain.TaskFilter) ([]int64, error) {
+	filter, err := s.scopeFilter(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.After = nil
+	filter.Limit = domain.MaxBatchSize + 1
+
+	tasks, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) > domain.MaxBatchSize {
+		return nil, ErrInvalidInput
+	}
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids, nil
+}
+
+// batchOrder returns the order in which the items of ids are applied. For
+// deletes, a selected task below another selected task is handled after its
+// ancestor; deletedWith maps such an item to that ancestor, since deleting the
+// ancestor already moves the whole subtree to the trash.
+func (s *TaskService) batchOrder(ctx context.Context, ids []int64, update domain.BatchUpdate) ([]int, map[int]int, error) {
+	order := make([]int, 0, len(ids))
+	if update.Action != domain.BATCH_DELETE {
+		for i := range ids {
+			order = append(order, i)
+		}
+		return order, nil, nil
+	}
+
+	index := make(map[int64]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+	nearest := make(map[int64]int64)
+	deletedWith := make(map[int]int)
+	var nested []int
+
+	frontier := ids
+	for len(frontier) > 0 {
+		children, err := s.repo.GetByParentIDs(ctx, frontier)
+		if err != nil {
+			return nil, nil, err
+		}
+		frontier = nil
+		for _, child := range children {
+			if _, ok := nearest[child.ID]; ok {
+				continue
+			}
+			ancestor := child.ParentID
+			if _, ok := index[ancestor]; !ok {
+				ancestor = nearest[ancestor]
+			}
+			nearest[child.ID] = ancestor
+			if i, ok := index[child.ID]; ok {
+				deletedWith[i] = index[ancestor]
+				nested = append(nested, i)
+			}
+			frontier = append(frontier, child.ID)
+		}
+	}
+
+	for i := range ids {
+		if _, ok := deletedWith[i]; !ok {
+			order = append(order, i)
+		}
+	}
+	return append(order, nested...), deletedWith, nil
+}
+
+func validateBatchUpdate(update domain.BatchUpdate) error {
+	switch update.Action {
+	case domain.BATCH_SET_STATUS:
+		to := update.Status.To
+		if to != domain.CREATED && to != domain.AT_WORK && to != domain.COMPLETED {
+			return ErrInvalidInput
+		}
+	case domain.BATCH_SET_DUE_DATE:
+		if update.DueDate.IsZero() {
+			return ErrInvalidInput
+		}
+	case domain.BATCH_MOVE_TO_PROJECT:
+		if update.ProjectID < 0 {
+			return ErrInvalidInput
+		}
+	case domain.BATCH_SET_TAGS:
+		if _, err := domain.NormalizeTags(update.Tags); err != nil {
+			return ErrInvalidInput
+		}
+	case domain.BATCH_DELETE:
+	default:
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+func uniqueIDs(ids []int64) ([]int64, error) {
+	seen := make(map[int64]struct{}, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, ErrInvalidInput
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// GetBoard returns the columns of a project board, or of the caller's personal
+// board when projectID is 0. Every column holds at most columnSize tasks.
+func (s *TaskService) GetBoard(ctx context.Context, token string, projectID int64, columnSize int) ([]domain.BoardColumn, error) {
+	if projectID < 0 {
+		logger.Log.Infof("task get board: invalid project_id=%d", projectID)
+		return nil, ErrInvalidInput
+	}
+	limit, err := normalizePageSize(columnSize)
+	if err != nil {
+		logger.Log.Infof("task get board: invalid column_size=%d", columnSize)
+		return nil, err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task get board: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	board := domain.Board{UserID: userID}
+	if projectID != 0 {
+		if _, err := s.projects.GetByIDAndMemberID(ctx, projectID, userID); err != nil {
+			logger.Log.Infof("task get board: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+			return nil, err
+		}
+		board = domain.Board{ProjectID: projectID}
+	}
+
+	columns := make([]domain.BoardColumn, 0, len(domain.BoardStatuses))
+	for _, status := range domain.BoardStatuses {
+		tasks, total, err := s.repo.GetBoardColumn(ctx, board, status, limit)
+		if err != nil {
+			logger.Log.Infof("task get board: repo error project_id=%d user_id=%d status=%v err=%v", projectID, userID, status, err)
+			return nil, err
+		}
+		columns = append(columns, domain.BoardColumn{Status: status, Tasks: tasks, Total: total})
+	}
+	logger.Log.Infof("task get board: success project_id=%d user_id=%d", projectID, userID)
+	return columns, nil
+}
+
+// MoveOnBoard places a task right below afterID in the column of change.To,
+// or on top of it when afterID is 0. A move to another column changes the
+// task status under the same rules as UpdateStatus.
+func (s *TaskService) MoveOnBoard(ctx context.Context, token string, id, version int64, change domain.StatusChange, afterID int64) (domain.Task, error) {
+	if id <= 0 || afterID < 0 || afterID == id {
+		logger.Log.Infof("task move on board: invalid input id=%d after_id=%d", id, afterID)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if !domain.IsBoardStatus(change.To) {
+		logger.Log.Infof("task move on board: invalid status=%v", change.To)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task move on board: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessStatus)
+	if err != nil {
+		logger.Log.Infof("task move on board: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if current.ParentID != 0 {
+		logger.Log.Infof("task move on board: subtask id=%d parent_id=%d", id, current.ParentID)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if err := current.CheckVersion(version); err != nil {
+		logger.Log.Infof("task move on board: version mismatch id=%d expected=%d actual=%d", id, version, current.Version)
+		return domain.Task{}, err
+	}
+	if change.To != current.Status {
+		if err := s.checkStatusChange(ctx, current, change); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	task, err := s.repo.MoveOnBoard(ctx, id, current.UserID, version, current.Status, change, afterID)
+	if err != nil {
+		logger.Log.Infof("task move on board: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task move on board: success id=%d user_id=%d status=%v after_id=%d", id, userID, change.To, afterID)
+
+	if change.To != current.Status {
+		s.afterStatusChange(ctx, task, userID, change)
+	}
+	return task, nil
+}
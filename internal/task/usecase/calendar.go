@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const (
+	icalTimeLayout    = "20060102T150405Z"
+	icalMaxLineOctets = 75
+)
+
+// CalendarFeedUsers resolves the secret calendar feed token issued by the
+// account service to its user.
+type CalendarFeedUsers interface {
+	GetUserIDByCalendarFeedToken(ctx context.Context, token string) (int64, error)
+}
+
+// CalendarService renders the iCalendar feed subscribed to by calendar apps.
+type CalendarService struct {
+	repo  domain.TaskRepository
+	users CalendarFeedUsers
+	now   func() time.Time
+}
+
+func NewCalendarService(repo domain.TaskRepository, users CalendarFeedUsers) *CalendarService {
+	return &CalendarService{repo: repo, users: users, now: time.Now}
+}
+
+// Feed writes the tasks owned by the user of feedToken to w as an iCalendar
+// file with one VTODO per task, subtasks included. An unknown or revoked
+// token yields ErrNotFound. It returns the number of tasks written.
+func (s *CalendarService) Feed(ctx context.Context, feedToken string, w io.Writer) (int, error) {
+	if feedToken == "" {
+		logger.Log.Infof("task calendar feed: empty token")
+		return 0, ErrInvalidInput
+	}
+
+	userID, err := s.users.GetUserIDByCalendarFeedToken(ctx, feedToken)
+	if err != nil {
+		logger.Log.Infof("task calendar feed: token error err=%v", err)
+		return 0, err
+	}
+
+	out := &icalWriter{w: bufio.NewWriter(w)}
+	out.line("BEGIN", "VCALENDAR")
+	out.line("VERSION", "2.0")
+	out.line("PRODID", "-//task-tracker//tasks//EN")
+	out.line("CALSCALE", "GREGORIAN")
+	out.line("X-WR-CALNAME", "Tasks")
+	out.line("REFRESH-INTERVAL;VALUE=DURATION", "PT1H")
+
+	stamp := s.now().UTC().Format(icalTimeLayout)
+	filter := domain.TaskFilter{UserID: userID, Sort: domain.SORT_CREATED_AT_ASC, Limit: exportPageSize}
+	count := 0
+	for {
+		tasks, err := s.repo.List(ctx, filter)
+		if err != nil {
+			logger.Log.Infof("task calendar feed: repo error user_id=%d err=%v", userID, err)
+			return count, err
+		}
+		for _, task := range tasks {
+			out.todo(task, stamp)
+			count++
+		}
+		if len(tasks) < exportPageSize {
+			break
+		}
+		cursor := filter.Sort.CursorOf(tasks[len(tasks)-1])
+		filter.After = &cursor
+	}
+	out.line("END", "VCALENDAR")
+
+	if err := out.flush(); err != nil {
+		logger.Log.Infof("task calendar feed: write error user_id=%d err=%v", userID, err)
+		return count, err
+	}
+	logger.Log.Infof("task calendar feed: success user_id=%d tasks=%d", userID, count)
+	return count, nil
+}
+
+// icalWriter writes content lines with CRLF endings, folded at 75 octets as
+// RFC 5545 requires. The first write error is kept and reported by flush.
+type icalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (w *icalWriter) todo(task domain.Task, stamp string) {
+	w.line("BEGIN", "VTODO")
+	w.line("UID", taskUID(task.ID))
+	w.line("DTSTAMP", stamp)
+	w.line("CREATED", task.CreatedAt.UTC().Format(icalTimeLayout))
+	w.line("DUE", task.DueDate.UTC().Format(icalTimeLayout))
+	w.line("SUMMARY", icalText(task.Description))
+	w.line("DESCRIPTION", icalText(task.Description))
+	w.line("STATUS", icalStatus(task.Status))
+	if priority := icalPriority(task.Priority); priority != 0 {
+		w.line("PRIORITY", strconv.Itoa(priority))
+	}
+	if len(task.Tags) > 0 {
+		categories := make([]string, 0, len(task.Tags))
+		for _, tag := range task.Tags {
+			categories = append(categories, icalText(tag))
+		}
+		w.line("CATEGORIES", strings.Join(categories, ","))
+	}
+	if task.ParentID != 0 {
+		w.line("RELATED-TO", taskUID(task.ParentID))
+	}
+	w.line("SEQUENCE", strconv.FormatInt(task.Version, 10))
+	w.line("END", "VTODO")
+}
+
+func (w *icalWriter) line(name, value string) {
+	if w.err != nil {
+		return
+	}
+	line := name + ":" + value
+	// A continuation line starts with a space, which counts toward its length.
+	limit := icalMaxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		if _, w.err = w.w.WriteString(line[:cut] + "\r\n "); w.err != nil {
+			return
+		}
+		line = line[cut:]
+		limit = icalMaxLineOctets - 1
+	}
+	_, w.err = w.w.WriteString(line + "\r\n")
+}
+
+func (w *icalWriter) flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.w.Flush()
+}
+
+func taskUID(id int64) string {
+	return "task-" + strconv.FormatInt(id, 10) + "@task-tracker"
+}
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func icalText(text string) string {
+	return icalTextEscaper.Replace(text)
+}
+
+// icalStatus maps a task status to the VTODO STATUS values. An expired task
+// is still open, so it needs action like a created one.
+func icalStatus(status domain.TaskStatus) string {
+	switch status {
+	case domain.AT_WORK:
+		return "IN-PROCESS"
+	case domain.COMPLETED:
+		return "COMPLETED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// icalPriority maps a task priority to the 1 (highest) to 9 (lowest) scale
+// of RFC 5545; 0 means the priority is left out.
+func icalPriority(priority domain.Priority) int {
+	switch priority {
+	case domain.URGENT:
+		return 1
+	case domain.HIGH:
+		return 3
+	case domain.MEDIUM:
+		return 5
+	case domain.LOW:
+		return 9
+	default:
+		return 0
+	}
+}
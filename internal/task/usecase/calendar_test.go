@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICalWriterLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		// want is the exact output; empty means only the folding rules are checked.
+		want string
+	}{
+		{name: "short line", value: "Pay rent", want: "SUMMARY:Pay rent\r\n"},
+		{name: "exactly 75 octets", value: strings.Repeat("a", 67), want: "SUMMARY:" + strings.Repeat("a", 67) + "\r\n"},
+		{
+			name:  "76 octets",
+			value: strings.Repeat("a", 68),
+			want:  "SUMMARY:" + strings.Repeat("a", 67) + "\r\n a\r\n",
+		},
+		{
+			name:  "continuation counts its leading space",
+			value: strings.Repeat("a", 67+74+1),
+			want:  "SUMMARY:" + strings.Repeat("a", 67) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			name:  "two-byte rune across the limit",
+			value: strings.Repeat("a", 66) + "яя",
+			want:  "SUMMARY:" + strings.Repeat("a", 66) + "\r\n яя\r\n",
+		},
+		{
+			name:  "four-byte rune across the limit",
+			value: strings.Repeat("a", 65) + "😀b",
+			want:  "SUMMARY:" + strings.Repeat("a", 65) + "\r\n 😀b\r\n",
+		},
+		{name: "long cyrillic text", value: strings.Repeat("Оплатить аренду, ", 20)},
+		{name: "long emoji text", value: strings.Repeat("😀 ", 60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := &icalWriter{w: bufio.NewWriter(&buf)}
+			w.line("SUMMARY", tt.value)
+			if err := w.flush(); err != nil {
+				t.Fatalf("flush: %v", err)
+			}
+			got := buf.String()
+			if tt.want != "" && got != tt.want {
+				t.Fatalf("line = %q, want %q", got, tt.want)
+			}
+
+			if !strings.HasSuffix(got, "\r\n") {
+				t.Fatalf("line %q does not end with CRLF", got)
+			}
+			physical := strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n")
+			for i, line := range physical {
+				if len(line) > icalMaxLineOctets {
+					t.Fatalf("physical line %d is %d octets long", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Fatalf("physical line %d = %q splits a rune", i, line)
+				}
+				if strings.ContainsAny(line, "\r\n") {
+					t.Fatalf("physical line %d = %q has a bare line break", i, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Fatalf("continuation line %d = %q does not start with a space", i, line)
+				}
+			}
+			if unfolded := strings.ReplaceAll(got, "\r\n ", ""); unfolded != "SUMMARY:"+tt.value+"\r\n" {
+				t.Fatalf("unfolded line = %q, want the original value", unfolded)
+			}
+		})
+	}
+}
+
+func TestICalText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain", text: "Pay rent", want: "Pay rent"},
+		{name: "backslash", text: `C:\reports`, want: `C:\\reports`},
+		{name: "separators", text: "milk; bread, eggs", want: `milk\; bread\, eggs`},
+		{name: "unix newline", text: "first\nsecond", want: `first\nsecond`},
+		{name: "windows newline", text: "first\r\nsecond", want: `first\nsecond`},
+		{name: "bare carriage return", text: "first\rsecond", want: `first\nsecond`},
+		{name: "escaped sequence is escaped again", text: `a\;b`, want: `a\\\;b`},
+		{name: "colon and quotes are kept", text: `Call "Bob": 5pm`, want: `Call "Bob": 5pm`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := icalText(tt.text); got != tt.want {
+				t.Fatalf("icalText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) ListComments(ctx context.Context, token string, taskID int64, pageSize int, pageToken string) ([]domain.Comment, string, error) {
+	if taskID <= 0 {
+		logger.Log.Infof("task list comments: invalid task_id=%d", taskID)
+		return nil, "", ErrInvalidInput
+	}
+	limit, err := normalizePageSize(pageSize)
+	if err != nil {
+		logger.Log.Infof("task list comments: invalid page size=%d", pageSize)
+		return nil, "", err
+	}
+	afterID, err := decodeIDToken(pageToken)
+	if err != nil {
+		logger.Log.Infof("task list comments: invalid page token")
+		return nil, "", err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list comments: invalid token err=%v", err)
+		return nil, "", ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task list comments: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return nil, "", err
+	}
+	comments, err := s.comments.GetByTaskID(ctx, taskID, afterID, limit+1)
+	if err != nil {
+		logger.Log.Infof("task list comments: repo error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if len(comments) > limit {
+		comments = comments[:limit]
+		nextPageToken = encodeIDToken(comments[limit-1].ID)
+	}
+	logger.Log.Infof("task list comments: success task_id=%d user_id=%d count=%d", taskID, userID, len(comments))
+	return comments, nextPageToken, nil
+}
+
+func (s *TaskService) AddComment(ctx context.Context, token string, taskID int64, body string) (domain.Comment, error) {
+	body, ok := normalizeCommentBody(body)
+	if taskID <= 0 || !ok {
+		logger.Log.Infof("task add comment: invalid input task_id=%d", taskID)
+		return domain.Comment{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task add comment: invalid token err=%v", err)
+		return domain.Comment{}, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessStatus); err != nil {
+		logger.Log.Infof("task add comment: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.Comment{}, err
+	}
+	comment, err := s.comments.Create(ctx, domain.Comment{
+		TaskID:    taskID,
+		UserID:    userID,
+		Body:      body,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		logger.Log.Infof("task add comment: repo error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.Comment{}, err
+	}
+	logger.Log.Infof("task add comment: success id=%d task_id=%d user_id=%d", comment.ID, taskID, userID)
+	return comment, nil
+}
+
+func (s *TaskService) UpdateComment(ctx context.Context, token string, taskID, id int64, body string) (domain.Comment, error) {
+	body, ok := normalizeCommentBody(body)
+	if taskID <= 0 || id <= 0 || !ok {
+		logger.Log.Infof("task update comment: invalid input task_id=%d id=%d", taskID, id)
+		return domain.Comment{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task update comment: invalid token err=%v", err)
+		return domain.Comment{}, ErrInvalidToken
+	}
+
+	if err := s.authorizeComment(ctx, taskID, id, userID); err != nil {
+		logger.Log.Infof("task update comment: access error task_id=%d id=%d user_id=%d err=%v", taskID, id, userID, err)
+		return domain.Comment{}, err
+	}
+	comment, err := s.comments.UpdateByIDAndUserID(ctx, id, userID, body, s.now())
+	if err != nil {
+		logger.Log.Infof("task update comment: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Comment{}, err
+	}
+	logger.Log.Infof("task update comment: success id=%d task_id=%d user_id=%d", id, taskID, userID)
+	return comment, nil
+}
+
+func (s *TaskService) DeleteComment(ctx context.Context, token string, taskID, id int64) error {
+	if taskID <= 0 || id <= 0 {
+		logger.Log.Infof("task delete comment: invalid input task_id=%d id=%d", taskID, id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task delete comment: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+
+	if err := s.authorizeComment(ctx, taskID, id, userID); err != nil {
+		logger.Log.Infof("task delete comment: access error task_id=%d id=%d user_id=%d err=%v", taskID, id, userID, err)
+		return err
+	}
+	if err := s.comments.DeleteByIDAndUserID(ctx, id, userID); err != nil {
+		logger.Log.Infof("task delete comment: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("task delete comment: success id=%d task_id=%d user_id=%d", id, taskID, userID)
+	return nil
+}
+
+// authorizeComment lets userID change a comment only while they can still see
+// the task and only if they wrote the comment.
+func (s *TaskService) authorizeComment(ctx context.Context, taskID, id, userID int64) error {
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		return err
+	}
+	comment, err := s.comments.GetByIDAndTaskID(ctx, id, taskID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != userID {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func normalizeCommentBody(body string) (string, bool) {
+	body = strings.TrimSpace(body)
+	return body, body != "" && utf8.RuneCountInString(body) <= domain.MaxCommentLength
+}
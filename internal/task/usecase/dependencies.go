@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) ListBlockers(ctx context.Context, token string, taskID int64) ([]domain.Task, error) {
+	if taskID <= 0 {
+		logger.Log.Infof("task list blockers: invalid task_id=%d", taskID)
+		return nil, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list blockers: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task list blockers: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return nil, err
+	}
+	blockers, err := s.repo.GetBlockersByTaskID(ctx, taskID)
+	if err != nil {
+		logger.Log.Infof("task list blockers: repo error task_id=%d err=%v", taskID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task list blockers: success task_id=%d user_id=%d count=%d", taskID, userID, len(blockers))
+	return blockers, nil
+}
+
+// AddBlocker marks taskID as blocked by blockerID. The caller must be able to
+// edit the blocked task and to see the blocker.
+func (s *TaskService) AddBlocker(ctx context.Context, token string, taskID, blockerID int64) (domain.Task, error) {
+	if taskID <= 0 || blockerID <= 0 {
+		logger.Log.Infof("task add blocker: invalid input task_id=%d blocker_id=%d", taskID, blockerID)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if taskID == blockerID {
+		logger.Log.Infof("task add blocker: self dependency task_id=%d", taskID)
+		return domain.Task{}, domain.ErrDependencyCycle
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task add blocker: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	task, err := s.authorizeTask(ctx, taskID, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task add blocker: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.Task{}, err
+	}
+	if _, err := s.authorizeTask(ctx, blockerID, userID, accessRead); err != nil {
+		logger.Log.Infof("task add blocker: blocker error blocker_id=%d user_id=%d err=%v", blockerID, userID, err)
+		return domain.Task{}, err
+	}
+	if slices.Contains(task.BlockedBy, blockerID) {
+		logger.Log.Infof("task add blocker: already linked task_id=%d blocker_id=%d", taskID, blockerID)
+		return task, nil
+	}
+	if len(task.BlockedBy) >= domain.MaxBlockers {
+		logger.Log.Infof("task add blocker: too many blockers task_id=%d count=%d", taskID, len(task.BlockedBy))
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	if err := s.repo.AddDependency(ctx, taskID, blockerID); err != nil {
+		logger.Log.Infof("task add blocker: repo error task_id=%d blocker_id=%d err=%v", taskID, blockerID, err)
+		return domain.Task{}, err
+	}
+	task, err = s.repo.GetByID(ctx, taskID)
+	if err != nil {
+		logger.Log.Infof("task add blocker: reload error task_id=%d err=%v", taskID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task add blocker: success task_id=%d blocker_id=%d user_id=%d", taskID, blockerID, userID)
+	return task, nil
+}
+
+func (s *TaskService) RemoveBlocker(ctx context.Context, token string, taskID, blockerID int64) (domain.Task, error) {
+	if taskID <= 0 || blockerID <= 0 {
+		logger.Log.Infof("task remove blocker: invalid input task_id=%d blocker_id=%d", taskID, blockerID)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task remove blocker: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessWrite); err != nil {
+		logger.Log.Infof("task remove blocker: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.Task{}, err
+	}
+	if err := s.repo.RemoveDependency(ctx, taskID, blockerID); err != nil {
+		logger.Log.Infof("task remove blocker: repo error task_id=%d blocker_id=%d err=%v", taskID, blockerID, err)
+		return domain.Task{}, err
+	}
+	task, err := s.repo.GetByID(ctx, taskID)
+	if err != nil {
+		logger.Log.Infof("task remove blocker: reload error task_id=%d err=%v", taskID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task remove blocker: success task_id=%d blocker_id=%d user_id=%d", taskID, blockerID, userID)
+	return task, nil
+}
+
+// notifyDependents publishes a BlockerCompleted event for every open task
+// that is blocked by the just completed blocker. Failures are only logged:
+// the status change itself has already been stored.
+func (s *TaskService) notifyDependents(ctx context.Context, blocker domain.Task) {
+	if s.events == nil {
+		return
+	}
+
+	dependents, err := s.repo.GetDependentsByBlockerID(ctx, blocker.ID)
+	if err != nil {
+		logger.Log.Infof("task notify dependents: repo error blocker_id=%d err=%v", blocker.ID, err)
+		return
+	}
+	for _, dependent := range dependents {
+		if dependent.Status == domain.COMPLETED {
+			continue
+		}
+		event := domain.BlockerCompleted{
+			TaskID:             dependent.ID,
+			UserID:             dependent.UserID,
+			AssigneeID:         dependent.AssigneeID,
+			Description:        dependent.Description,
+			BlockerID:          blocker.ID,
+			BlockerDescription: blocker.Description,
+			Remaining:          dependent.OpenBlockers,
+		}
+		if err := s.events.PublishBlockerCompleted(ctx, event); err != nil {
+			logger.Log.Infof("task notify dependents: publish error task_id=%d blocker_id=%d err=%v", dependent.ID, blocker.ID, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"task-tracker/internal/task/domain"
+)
+
+// dependencyRepository keeps tasks of user 1 in memory and fails
+// AddDependency with addErr; any other repository call panics on the nil
+// embedded interface.
+type dependencyRepository struct {
+	domain.TaskRepository
+	tasks  map[int64]domain.Task
+	addErr error
+	added  [][2]int64
+}
+
+func (r *dependencyRepository) GetByID(_ context.Context, id int64) (domain.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok {
+		return domain.Task{}, domain.ErrNotFound
+	}
+	return task, nil
+}
+
+func (r *dependencyRepository) AddDependency(_ context.Context, taskID, blockerID int64) error {
+	if r.addErr != nil {
+		return r.addErr
+	}
+	r.added = append(r.added, [2]int64{taskID, blockerID})
+	return nil
+}
+
+func TestAddBlocker(t *testing.T) {
+	full := make([]int64, domain.MaxBlockers)
+	for i := range full {
+		full[i] = int64(100 + i)
+	}
+
+	tests := []struct {
+		name      string
+		blockedBy []int64
+		taskID    int64
+		blockerID int64
+		addErr    error
+		wantErr   error
+		wantAdded [][2]int64
+	}{
+		{name: "linked", taskID: 1, blockerID: 2, wantAdded: [][2]int64{{1, 2}}},
+		{name: "self dependency", taskID: 1, blockerID: 1, wantErr: domain.ErrDependencyCycle},
+		{name: "cycle found by the repository", taskID: 1, blockerID: 2, addErr: domain.ErrDependencyCycle, wantErr: domain.ErrDependencyCycle},
+		{name: "already linked", blockedBy: []int64{2}, taskID: 1, blockerID: 2},
+		{name: "too many blockers", blockedBy: full, taskID: 1, blockerID: 2, wantErr: ErrInvalidInput},
+		{name: "unknown blocker", taskID: 1, blockerID: 3, wantErr: domain.ErrNotFound},
+		{name: "invalid id", taskID: 0, blockerID: 2, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &dependencyRepository{
+				tasks: map[int64]domain.Task{
+					1: {ID: 1, UserID: 1, BlockedBy: tt.blockedBy},
+					2: {ID: 2, UserID: 1},
+				},
+				addErr: tt.addErr,
+			}
+			svc := NewTaskService(repo, nil, nil, nil, nil, fakeTokens{}, nil, 0)
+
+			_, err := svc.AddBlocker(context.Background(), "token", tt.taskID, tt.blockerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddBlocker error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(repo.added, tt.wantAdded) {
+				t.Fatalf("added dependencies %v, want %v", repo.added, tt.wantAdded)
+			}
+		})
+	}
+}
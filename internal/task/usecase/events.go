@@ -3,12 +3,43 @@ package usecase
 import (
 	"context"
 	"time"
+
+	"task-tracker/internal/task/domain"
 )
 
+type PrioritySummary struct {
+	Priority     domain.Priority
+	Completed    int
+	NotCompleted int
+}
+
 type UserExpiredSummary struct {
 	UserID       int64
 	Completed    int
 	NotCompleted int
+	ByPriority   []PrioritySummary
+}
+
+func (s *UserExpiredSummary) add(priority domain.Priority, completed bool) {
+	index := -1
+	for i := range s.ByPriority {
+		if s.ByPriority[i].Priority == priority {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		s.ByPriority = append(s.ByPriority, PrioritySummary{Priority: priority})
+		index = len(s.ByPriority) - 1
+	}
+
+	if completed {
+		s.Completed++
+		s.ByPriority[index].Completed++
+	} else {
+		s.NotCompleted++
+		s.ByPriority[index].NotCompleted++
+	}
 }
 
 type ExpiredSummary struct {
@@ -19,4 +50,6 @@ type ExpiredSummary struct {
 
 type TaskEventPublisher interface {
 	PublishExpiredSummary(ctx context.Context, summary ExpiredSummary) error
+	PublishReminder(ctx context.Context, reminder domain.Reminder) error
+	PublishBlockerCompleted(ctx context.Context, event domain.BlockerCompleted) error
 }
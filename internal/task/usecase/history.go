@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) GetHistory(ctx context.Context, token string, id int64) ([]domain.TaskEvent, error) {
+	if id <= 0 {
+		logger.Log.Infof("task get history: invalid id=%d", id)
+		return nil, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task get history: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	// Events outlive their task, so a trashed task keeps its history.
+	_, err = s.authorizeTask(ctx, id, userID, accessRead)
+	if errors.Is(err, domain.ErrNotFound) {
+		_, err = s.authorizeDeleted(ctx, id, userID, accessRead)
+	}
+	if err != nil {
+		logger.Log.Infof("task get history: access error id=%d user_id=%d err=%v", id, userID, err)
+		return nil, err
+	}
+	events, err := s.repo.GetEventsByTaskID(ctx, id)
+	if err != nil {
+		logger.Log.Infof("task get history: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task get history: success id=%d user_id=%d count=%d", id, userID, len(events))
+	return events, nil
+}
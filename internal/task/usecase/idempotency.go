@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type IdempotencyService struct {
+	repo   domain.IdempotencyRepository
+	tokens TokenParser
+	lease  time.Duration
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewIdempotencyService keeps a key reserved for lease while its request
+// runs, so a key left behind by a crashed request can be claimed again once
+// the lease runs out, and keeps a completed response for ttl.
+func NewIdempotencyService(repo domain.IdempotencyRepository, tokens TokenParser, lease, ttl time.Duration) *IdempotencyService {
+	return &IdempotencyService{repo: repo, tokens: tokens, lease: lease, ttl: ttl, now: time.Now}
+}
+
+// Begin claims key for the caller. It returns the stored response when the
+// same request already completed; otherwise the caller runs the request and
+// then passes the returned reservation to Complete or Release.
+func (s *IdempotencyService) Begin(ctx context.Context, token, key, method, requestHash string) (domain.IdempotencyRecord, []byte, error) {
+	if key == "" || len(key) > domain.MaxIdempotencyKeyLength {
+		logger.Log.Infof("idempotency begin: invalid key length=%d", len(key))
+		return domain.IdempotencyRecord{}, nil, domain.ErrInvalidIdempotencyKey
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("idempotency begin: invalid token err=%v", err)
+		return domain.IdempotencyRecord{}, nil, ErrInvalidToken
+	}
+
+	// The reservation is matched by created_at later, so keep it at the
+	// microsecond precision the database stores.
+	now := s.now().Truncate(time.Microsecond)
+	record := domain.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.lease),
+	}
+	reserved, err := s.repo.Reserve(ctx, record)
+	if err != nil {
+		logger.Log.Infof("idempotency begin: repo error user_id=%d err=%v", userID, err)
+		return domain.IdempotencyRecord{}, nil, err
+	}
+	if reserved {
+		logger.Log.Infof("idempotency begin: reserved user_id=%d method=%s", userID, method)
+		return record, nil, nil
+	}
+
+	stored, err := s.repo.GetByUserIDAndKey(ctx, userID, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			logger.Log.Infof("idempotency begin: released concurrently user_id=%d", userID)
+			return domain.IdempotencyRecord{}, nil, domain.ErrIdempotencyInProgress
+		}
+		logger.Log.Infof("idempotency begin: repo error user_id=%d err=%v", userID, err)
+		return domain.IdempotencyRecord{}, nil, err
+	}
+	if stored.Method != method || stored.RequestHash != requestHash {
+		logger.Log.Infof("idempotency begin: key reused user_id=%d method=%s stored_method=%s", userID, method, stored.Method)
+		return domain.IdempotencyRecord{}, nil, domain.ErrIdempotencyKeyReused
+	}
+	if stored.Response == nil {
+		logger.Log.Infof("idempotency begin: in progress user_id=%d method=%s", userID, method)
+		return domain.IdempotencyRecord{}, nil, domain.ErrIdempotencyInProgress
+	}
+	logger.Log.Infof("idempotency begin: replay user_id=%d method=%s", userID, method)
+	return stored, stored.Response, nil
+}
+
+func (s *IdempotencyService) Complete(ctx context.Context, reservation domain.IdempotencyRecord, response []byte) error {
+	if err := s.repo.SaveResponse(ctx, reservation, response, s.now().Add(s.ttl)); err != nil {
+		logger.Log.Infof("idempotency complete: repo error user_id=%d err=%v", reservation.UserID, err)
+		return err
+	}
+	return nil
+}
+
+func (s *IdempotencyService) Release(ctx context.Context, reservation domain.IdempotencyRecord) error {
+	if err := s.repo.DeleteReservation(ctx, reservation); err != nil {
+		logger.Log.Infof("idempotency release: repo error user_id=%d err=%v", reservation.UserID, err)
+		return err
+	}
+	return nil
+}
+
+func (s *IdempotencyService) PurgeExpired(ctx context.Context) error {
+	purged, err := s.repo.DeleteExpired(ctx, s.now())
+	if err != nil {
+		logger.Log.Infof("idempotency purge expired: repo error err=%v", err)
+		return err
+	}
+	logger.Log.Infof("idempotency purge expired: success count=%d", purged)
+	return nil
+}
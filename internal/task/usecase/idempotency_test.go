@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+type fakeTokens struct{}
+
+func (fakeTokens) ParseUserID(token string) (int64, error) {
+	if token != "token" {
+		return 0, errors.New("invalid token")
+	}
+	return 1, nil
+}
+
+func (t fakeTokens) ParseUserIDAndTimeZone(token string) (int64, string, error) {
+	userID, err := t.ParseUserID(token)
+	return userID, "", err
+}
+
+// fakeIdempotencyRepository keeps records in memory with the takeover rule of
+// the real Reserve: only an expired record can be replaced.
+type fakeIdempotencyRepository struct {
+	records map[string]domain.IdempotencyRecord
+}
+
+func (r *fakeIdempotencyRepository) Reserve(_ context.Context, record domain.IdempotencyRecord) (bool, error) {
+	if stored, ok := r.records[record.Key]; ok && stored.ExpiresAt.After(record.CreatedAt) {
+		return false, nil
+	}
+	r.records[record.Key] = record
+	return true, nil
+}
+
+func (r *fakeIdempotencyRepository) GetByUserIDAndKey(_ context.Context, _ int64, key string) (domain.IdempotencyRecord, error) {
+	record, ok := r.records[key]
+	if !ok {
+		return domain.IdempotencyRecord{}, domain.ErrNotFound
+	}
+	return record, nil
+}
+
+func (r *fakeIdempotencyRepository) SaveResponse(_ context.Context, reservation domain.IdempotencyRecord, response []byte, expiresAt time.Time) error {
+	record, ok := r.records[reservation.Key]
+	if ok && record.CreatedAt.Equal(reservation.CreatedAt) && record.RequestHash == reservation.RequestHash {
+		record.Response, record.ExpiresAt = response, expiresAt
+		r.records[reservation.Key] = record
+	}
+	return nil
+}
+
+func (r *fakeIdempotencyRepository) DeleteReservation(_ context.Context, reservation domain.IdempotencyRecord) error {
+	record, ok := r.records[reservation.Key]
+	if ok && record.CreatedAt.Equal(reservation.CreatedAt) && record.RequestHash == reservation.RequestHash {
+		delete(r.records, reservation.Key)
+	}
+	return nil
+}
+
+func (r *fakeIdempotencyRepository) DeleteExpired(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestIdempotencyBegin(t *testing.T) {
+	start := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	const (
+		lease  = time.Minute
+		ttl    = 24 * time.Hour
+		method = "/task.TaskService/CreateTask"
+	)
+
+	type call struct {
+		after    time.Duration
+		token    string
+		key      string
+		method   string
+		hash     string
+		complete []byte
+		release  bool
+	}
+	tests := []struct {
+		name         string
+		earlier      []call
+		call         call
+		wantErr      error
+		wantReserved bool
+		wantReplay   []byte
+	}{
+		{
+			name:         "new key is reserved",
+			call:         call{token: "token", key: "k", method: method, hash: "h"},
+			wantReserved: true,
+		},
+		{
+			name:    "empty key",
+			call:    call{token: "token", key: "", method: method, hash: "h"},
+			wantErr: domain.ErrInvalidIdempotencyKey,
+		},
+		{
+			name:    "invalid token",
+			call:    call{token: "bad", key: "k", method: method, hash: "h"},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:       "completed request is replayed",
+			earlier:    []call{{token: "token", key: "k", method: method, hash: "h", complete: []byte("response")}},
+			call:       call{after: time.Hour, token: "token", key: "k", method: method, hash: "h"},
+			wantReplay: []byte("response"),
+		},
+		{
+			name:    "key reused with a different request",
+			earlier: []call{{token: "token", key: "k", method: method, hash: "h", complete: []byte("response")}},
+			call:    call{token: "token", key: "k", method: method, hash: "other"},
+			wantErr: domain.ErrIdempotencyKeyReused,
+		},
+		{
+			name:    "key reused with a different method",
+			earlier: []call{{token: "token", key: "k", method: method, hash: "h", complete: []byte("response")}},
+			call:    call{token: "token", key: "k", method: "/task.TaskService/DeleteTask", hash: "h"},
+			wantErr: domain.ErrIdempotencyKeyReused,
+		},
+		{
+			name:    "request still in progress",
+			earlier: []call{{token: "token", key: "k", method: method, hash: "h"}},
+			call:    call{after: lease / 2, token: "token", key: "k", method: method, hash: "h"},
+			wantErr: domain.ErrIdempotencyInProgress,
+		},
+		{
+			name:         "expired lease is taken over",
+			earlier:      []call{{token: "token", key: "k", method: method, hash: "h"}},
+			call:         call{after: lease, token: "token", key: "k", method: method, hash: "h"},
+			wantReserved: true,
+		},
+		{
+			name:         "released key is reserved again",
+			earlier:      []call{{token: "token", key: "k", method: method, hash: "h", release: true}},
+			call:         call{token: "token", key: "k", method: method, hash: "other"},
+			wantReserved: true,
+		},
+		{
+			name:         "expired response is taken over",
+			earlier:      []call{{token: "token", key: "k", method: method, hash: "h", complete: []byte("response")}},
+			call:         call{after: ttl, token: "token", key: "k", method: method, hash: "other"},
+			wantReserved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeIdempotencyRepository{records: make(map[string]domain.IdempotencyRecord)}
+			svc := NewIdempotencyService(repo, fakeTokens{}, lease, ttl)
+			ctx := context.Background()
+
+			for _, c := range tt.earlier {
+				svc.now = func() time.Time { return start.Add(c.after) }
+				reservation, _, err := svc.Begin(ctx, c.token, c.key, c.method, c.hash)
+				if err != nil {
+					t.Fatalf("earlier Begin: %v", err)
+				}
+				if c.complete != nil {
+					if err := svc.Complete(ctx, reservation, c.complete); err != nil {
+						t.Fatalf("Complete: %v", err)
+					}
+				}
+				if c.release {
+					if err := svc.Release(ctx, reservation); err != nil {
+						t.Fatalf("Release: %v", err)
+					}
+				}
+			}
+
+			svc.now = func() time.Time { return start.Add(tt.call.after) }
+			reservation, replay, err := svc.Begin(ctx, tt.call.token, tt.call.key, tt.call.method, tt.call.hash)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Begin error = %v, want %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(replay, tt.wantReplay) {
+				t.Fatalf("Begin replay = %q, want %q", replay, tt.wantReplay)
+			}
+			if tt.wantReserved {
+				stored := repo.records[tt.call.key]
+				if !stored.CreatedAt.Equal(reservation.CreatedAt) || stored.RequestHash != tt.call.hash || stored.Response != nil {
+					t.Fatalf("stored record = %+v, want the reservation %+v", stored, reservation)
+				}
+				if want := reservation.CreatedAt.Add(lease); !stored.ExpiresAt.Equal(want) {
+					t.Fatalf("reservation expires at %v, want the lease end %v", stored.ExpiresAt, want)
+				}
+			}
+		})
+	}
+}
+
+// TestIdempotencyStaleRequest checks that a request whose lease was taken
+// over can no longer store or free the key.
+func TestIdempotencyStaleRequest(t *testing.T) {
+	start := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	repo := &fakeIdempotencyRepository{records: make(map[string]domain.IdempotencyRecord)}
+	svc := NewIdempotencyService(repo, fakeTokens{}, time.Minute, time.Hour)
+	ctx := context.Background()
+
+	svc.now = func() time.Time { return start }
+	stale, _, err := svc.Begin(ctx, "token", "k", "m", "h")
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	svc.now = func() time.Time { return start.Add(2 * time.Minute) }
+	current, _, err := svc.Begin(ctx, "token", "k", "m", "h")
+	if err != nil {
+		t.Fatalf("Begin after the lease: %v", err)
+	}
+
+	if err := svc.Complete(ctx, stale, []byte("stale")); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if err := svc.Release(ctx, stale); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	stored, ok := repo.records["k"]
+	if !ok || !stored.CreatedAt.Equal(current.CreatedAt) || stored.Response != nil {
+		t.Fatalf("stored record = %+v, want the newer reservation untouched", stored)
+	}
+}
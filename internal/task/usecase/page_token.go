@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+type pageToken struct {
+	Sort  domain.TaskSort `json:"s"`
+	Value int64           `json:"v"`
+	ID    int64           `json:"id"`
+}
+
+func encodePageToken(sort domain.TaskSort, cursor domain.TaskCursor) string {
+	data, err := json.Marshal(pageToken{Sort: sort, Value: cursor.Value.UnixNano(), ID: cursor.ID})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string, sort domain.TaskSort) (*domain.TaskCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, ErrInvalidInput
+	}
+	if decoded.Sort != sort || decoded.ID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	return &domain.TaskCursor{Value: time.Unix(0, decoded.Value), ID: decoded.ID}, nil
+}
+
+func normalizePageSize(size int) (int, error) {
+	switch {
+	case size < 0:
+		return 0, ErrInvalidInput
+	case size == 0:
+		return defaultPageSize, nil
+	case size > maxPageSize:
+		return maxPageSize, nil
+	default:
+		return size, nil
+	}
+}
+
+type offsetToken struct {
+	Offset int `json:"o"`
+}
+
+func encodeOffsetToken(offset int) string {
+	data, err := json.Marshal(offsetToken{Offset: offset})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeOffsetToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidInput
+	}
+	var decoded offsetToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Offset <= 0 {
+		return 0, ErrInvalidInput
+	}
+	return decoded.Offset, nil
+}
+
+type idToken struct {
+	ID int64 `json:"id"`
+}
+
+func encodeIDToken(id int64) string {
+	data, err := json.Marshal(idToken{ID: id})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeIDToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidInput
+	}
+	var decoded idToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID <= 0 {
+		return 0, ErrInvalidInput
+	}
+	return decoded.ID, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   domain.TaskSort
+		cursor domain.TaskCursor
+	}{
+		{name: "due date ascending", sort: domain.SORT_DUE_DATE_ASC, cursor: domain.TaskCursor{Value: time.Date(2026, time.October, 20, 23, 59, 59, 0, time.UTC), ID: 7}},
+		{name: "due date descending", sort: domain.SORT_DUE_DATE_DESC, cursor: domain.TaskCursor{Value: time.Date(2026, time.October, 20, 23, 59, 59, 0, time.UTC), ID: 7}},
+		{name: "created at with nanoseconds", sort: domain.SORT_CREATED_AT_ASC, cursor: domain.TaskCursor{Value: time.Date(2026, time.October, 1, 9, 0, 0, 123456789, time.UTC), ID: 1}},
+		{name: "created at in another zone", sort: domain.SORT_CREATED_AT_DESC, cursor: domain.TaskCursor{Value: time.Date(2026, time.October, 1, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60)), ID: 42}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := encodePageToken(tt.sort, tt.cursor)
+			if token == "" {
+				t.Fatalf("encodePageToken returned an empty token")
+			}
+			got, err := decodePageToken(token, tt.sort)
+			if err != nil {
+				t.Fatalf("decodePageToken: %v", err)
+			}
+			if got == nil || !got.Value.Equal(tt.cursor.Value) || got.ID != tt.cursor.ID {
+				t.Fatalf("decodePageToken = %+v, want %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodePageToken(t *testing.T) {
+	encode := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+	valid := encodePageToken(domain.SORT_DUE_DATE_ASC, domain.TaskCursor{Value: time.Unix(0, 1), ID: 5})
+
+	tests := []struct {
+		name       string
+		token      string
+		sort       domain.TaskSort
+		wantCursor bool
+		wantErr    error
+	}{
+		{name: "first page", token: "", sort: domain.SORT_DUE_DATE_ASC},
+		{name: "valid token", token: valid, sort: domain.SORT_DUE_DATE_ASC, wantCursor: true},
+		{name: "token of another sort", token: valid, sort: domain.SORT_CREATED_AT_ASC, wantErr: ErrInvalidInput},
+		{name: "not base64", token: "not a token!", sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+		{name: "padded base64", token: valid + "=", sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+		{name: "not json", token: encode("cursor"), sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+		{name: "missing id", token: encode(`{"s":0,"v":1}`), sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+		{name: "negative id", token: encode(`{"s":0,"v":1,"id":-3}`), sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+		{name: "wrong value type", token: encode(`{"s":0,"v":"2026-10-20","id":3}`), sort: domain.SORT_DUE_DATE_ASC, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := decodePageToken(tt.token, tt.sort)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodePageToken error = %v, want %v", err, tt.wantErr)
+			}
+			if (cursor != nil) != tt.wantCursor {
+				t.Fatalf("decodePageToken cursor = %+v, want cursor %v", cursor, tt.wantCursor)
+			}
+		})
+	}
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		want    int
+		wantErr error
+	}{
+		{name: "default", size: 0, want: defaultPageSize},
+		{name: "as requested", size: 10, want: 10},
+		{name: "maximum", size: maxPageSize, want: maxPageSize},
+		{name: "capped", size: maxPageSize + 1, want: maxPageSize},
+		{name: "negative", size: -1, wantErr: ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizePageSize(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("normalizePageSize(%d) error = %v, want %v", tt.size, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("normalizePageSize(%d) = %d, want %d", tt.size, got, tt.want)
+			}
+		})
+	}
+}
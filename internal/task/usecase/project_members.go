@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *ProjectService) ListMembers(ctx context.Context, token string, projectID int64) ([]domain.ProjectMember, error) {
+	if projectID <= 0 {
+		logger.Log.Infof("project list members: invalid project id=%d", projectID)
+		return nil, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project list members: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	if _, err := s.repo.GetByIDAndMemberID(ctx, projectID, userID); err != nil {
+		logger.Log.Infof("project list members: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+		return nil, err
+	}
+	members, err := s.repo.GetMembers(ctx, projectID)
+	if err != nil {
+		logger.Log.Infof("project list members: repo error project_id=%d err=%v", projectID, err)
+		return nil, err
+	}
+	if err := s.withEmails(ctx, members); err != nil {
+		logger.Log.Infof("project list members: users error project_id=%d err=%v", projectID, err)
+		return nil, err
+	}
+	logger.Log.Infof("project list members: success project_id=%d count=%d", projectID, len(members))
+	return members, nil
+}
+
+func (s *ProjectService) AddMember(ctx context.Context, token string, projectID int64, email string, role domain.ProjectRole) (domain.ProjectMember, error) {
+	email = strings.TrimSpace(email)
+	if projectID <= 0 || email == "" || role == domain.OWNER || !role.Valid() {
+		logger.Log.Infof("project add member: invalid input project_id=%d role=%v", projectID, role)
+		return domain.ProjectMember{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project add member: invalid token err=%v", err)
+		return domain.ProjectMember{}, ErrInvalidToken
+	}
+
+	project, err := s.ownedProject(ctx, projectID, userID)
+	if err != nil {
+		logger.Log.Infof("project add member: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+		return domain.ProjectMember{}, err
+	}
+	memberID, err := s.users.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		logger.Log.Infof("project add member: user lookup error project_id=%d err=%v", projectID, err)
+		return domain.ProjectMember{}, err
+	}
+	if memberID == project.UserID {
+		logger.Log.Infof("project add member: owner role change project_id=%d", projectID)
+		return domain.ProjectMember{}, ErrInvalidInput
+	}
+
+	member, err := s.repo.SaveMember(ctx, domain.ProjectMember{
+		ProjectID: projectID,
+		UserID:    memberID,
+		Role:      role,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		logger.Log.Infof("project add member: repo error project_id=%d member_id=%d err=%v", projectID, memberID, err)
+		return domain.ProjectMember{}, err
+	}
+	member.Email = email
+	logger.Log.Infof("project add member: success project_id=%d member_id=%d role=%v", projectID, memberID, role)
+	return member, nil
+}
+
+func (s *ProjectService) UpdateMemberRole(ctx context.Context, token string, projectID, memberID int64, role domain.ProjectRole) (domain.ProjectMember, error) {
+	if projectID <= 0 || memberID <= 0 || role == domain.OWNER || !role.Valid() {
+		logger.Log.Infof("project update member: invalid input project_id=%d member_id=%d", projectID, memberID)
+		return domain.ProjectMember{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project update member: invalid token err=%v", err)
+		return domain.ProjectMember{}, ErrInvalidToken
+	}
+
+	project, err := s.ownedProject(ctx, projectID, userID)
+	if err != nil {
+		logger.Log.Infof("project update member: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+		return domain.ProjectMember{}, err
+	}
+	if memberID == project.UserID {
+		logger.Log.Infof("project update member: owner role change project_id=%d", projectID)
+		return domain.ProjectMember{}, ErrInvalidInput
+	}
+	if _, err := s.repo.GetByIDAndMemberID(ctx, projectID, memberID); err != nil {
+		logger.Log.Infof("project update member: member error project_id=%d member_id=%d err=%v", projectID, memberID, err)
+		return domain.ProjectMember{}, err
+	}
+
+	member, err := s.repo.SaveMember(ctx, domain.ProjectMember{
+		ProjectID: projectID,
+		UserID:    memberID,
+		Role:      role,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		logger.Log.Infof("project update member: repo error project_id=%d member_id=%d err=%v", projectID, memberID, err)
+		return domain.ProjectMember{}, err
+	}
+	members := []domain.ProjectMember{member}
+	if err := s.withEmails(ctx, members); err != nil {
+		logger.Log.Infof("project update member: users error project_id=%d err=%v", projectID, err)
+		return domain.ProjectMember{}, err
+	}
+	logger.Log.Infof("project update member: success project_id=%d member_id=%d role=%v", projectID, memberID, role)
+	return members[0], nil
+}
+
+func (s *ProjectService) RemoveMember(ctx context.Context, token string, projectID, memberID int64) error {
+	if projectID <= 0 || memberID <= 0 {
+		logger.Log.Infof("project remove member: invalid input project_id=%d member_id=%d", projectID, memberID)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project remove member: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	project, err := s.repo.GetByIDAndMemberID(ctx, projectID, userID)
+	if err != nil {
+		logger.Log.Infof("project remove member: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+		return err
+	}
+	if memberID == project.UserID {
+		logger.Log.Infof("project remove member: owner removal project_id=%d", projectID)
+		return ErrInvalidInput
+	}
+	if memberID != userID && project.Role != domain.OWNER {
+		logger.Log.Infof("project remove member: forbidden project_id=%d user_id=%d", projectID, userID)
+		return domain.ErrForbidden
+	}
+
+	if err := s.repo.DeleteMember(ctx, projectID, memberID); err != nil {
+		logger.Log.Infof("project remove member: repo error project_id=%d member_id=%d err=%v", projectID, memberID, err)
+		return err
+	}
+	logger.Log.Infof("project remove member: success project_id=%d member_id=%d", projectID, memberID)
+	return nil
+}
+
+func (s *ProjectService) ownedProject(ctx context.Context, projectID, userID int64) (domain.Project, error) {
+	project, err := s.repo.GetByIDAndMemberID(ctx, projectID, userID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	if project.Role != domain.OWNER {
+		return domain.Project{}, domain.ErrForbidden
+	}
+	return project, nil
+}
+
+func (s *ProjectService) withEmails(ctx context.Context, members []domain.ProjectMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+	emails, err := s.users.GetEmailsByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range members {
+		members[i].Email = emails[members[i].UserID]
+	}
+	return nil
+}
+
+func (s *TaskService) Assign(ctx context.Context, token string, id, version, assigneeID int64) (domain.Task, error) {
+	if id <= 0 || assigneeID < 0 {
+		logger.Log.Infof("task assign: invalid input id=%d assignee_id=%d", id, assigneeID)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task assign: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task assign: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if assigneeID != 0 {
+		if current.ProjectID == 0 {
+			logger.Log.Infof("task assign: task without project id=%d", id)
+			return domain.Task{}, ErrInvalidInput
+		}
+		if _, err := s.projects.GetByIDAndMemberID(ctx, current.ProjectID, assigneeID); err != nil {
+			logger.Log.Infof("task assign: assignee error id=%d assignee_id=%d err=%v", id, assigneeID, err)
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.Task{}, ErrInvalidInput
+			}
+			return domain.Task{}, err
+		}
+	}
+
+	task, err := s.repo.UpdateAssigneeByIDAndUserID(ctx, id, current.UserID, version, assigneeID)
+	if err != nil {
+		logger.Log.Infof("task assign: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task assign: success id=%d user_id=%d assignee_id=%d", id, userID, assigneeID)
+	return task, nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+type ProjectService struct {
+	repo   domain.ProjectRepository
+	tokens TokenParser
+	users  UserDirectory
+	now    func() time.Time
+}
+
+func NewProjectService(repo domain.ProjectRepository, tokens TokenParser, users UserDirectory) *ProjectService {
+	return &ProjectService{repo: repo, tokens: tokens, users: users, now: time.Now}
+}
+
+func (s *ProjectService) Create(ctx context.Context, token string, draft domain.Project) (domain.Project, error) {
+	name, err := domain.NormalizeProjectName(draft.Name)
+	if err != nil {
+		logger.Log.Infof("project create: invalid name")
+		return domain.Project{}, ErrInvalidInput
+	}
+	color, err := domain.NormalizeProjectColor(draft.Color)
+	if err != nil {
+		logger.Log.Infof("project create: invalid color=%q", draft.Color)
+		return domain.Project{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project create: invalid token err=%v", err)
+		return domain.Project{}, ErrInvalidToken
+	}
+
+	project := domain.Project{
+		UserID:    userID,
+		Name:      name,
+		Color:     color,
+		CreatedAt: s.now(),
+	}
+	created, err := s.repo.Create(ctx, project)
+	if err != nil {
+		logger.Log.Infof("project create: repo error user_id=%d err=%v", userID, err)
+		return domain.Project{}, err
+	}
+	logger.Log.Infof("project create: success id=%d user_id=%d", created.ID, userID)
+	return created, nil
+}
+
+func (s *ProjectService) GetByID(ctx context.Context, token string, id int64) (domain.Project, error) {
+	if id <= 0 {
+		logger.Log.Infof("project get by id: invalid id=%d", id)
+		return domain.Project{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project get by id: invalid token err=%v", err)
+		return domain.Project{}, ErrInvalidToken
+	}
+
+	project, err := s.repo.GetByIDAndMemberID(ctx, id, userID)
+	if err != nil {
+		logger.Log.Infof("project get by id: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Project{}, err
+	}
+	counts, err := s.repo.CountTasksByProjectIDs(ctx, []int64{project.ID})
+	if err != nil {
+		logger.Log.Infof("project get by id: count error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Project{}, err
+	}
+	project.Counts = counts[project.ID]
+	logger.Log.Infof("project get by id: success id=%d user_id=%d", id, userID)
+	return project, nil
+}
+
+func (s *ProjectService) List(ctx context.Context, token string, includeArchived bool) ([]domain.Project, error) {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project list: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	projects, err := s.repo.GetByMemberID(ctx, userID, includeArchived)
+	if err != nil {
+		logger.Log.Infof("project list: repo error user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	ids := make([]int64, 0, len(projects))
+	for _, project := range projects {
+		ids = append(ids, project.ID)
+	}
+	counts, err := s.repo.CountTasksByProjectIDs(ctx, ids)
+	if err != nil {
+		logger.Log.Infof("project list: count error user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	for i := range projects {
+		projects[i].Counts = counts[projects[i].ID]
+	}
+	logger.Log.Infof("project list: success user_id=%d count=%d", userID, len(projects))
+	return projects, nil
+}
+
+func (s *ProjectService) Update(ctx context.Context, token string, id int64, update domain.ProjectUpdate) (domain.Project, error) {
+	if id <= 0 || update.IsEmpty() {
+		logger.Log.Infof("project update: invalid input id=%d", id)
+		return domain.Project{}, ErrInvalidInput
+	}
+	if update.Name != nil {
+		name, err := domain.NormalizeProjectName(*update.Name)
+		if err != nil {
+			logger.Log.Infof("project update: invalid name id=%d", id)
+			return domain.Project{}, ErrInvalidInput
+		}
+		update.Name = &name
+	}
+	if update.Color != nil {
+		color, err := domain.NormalizeProjectColor(*update.Color)
+		if err != nil {
+			logger.Log.Infof("project update: invalid color id=%d color=%q", id, *update.Color)
+			return domain.Project{}, ErrInvalidInput
+		}
+		update.Color = &color
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project update: invalid token err=%v", err)
+		return domain.Project{}, ErrInvalidToken
+	}
+	if _, err := s.ownedProject(ctx, id, userID); err != nil {
+		logger.Log.Infof("project update: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Project{}, err
+	}
+
+	project, err := s.repo.UpdateByIDAndUserID(ctx, id, userID, update)
+	if err != nil {
+		logger.Log.Infof("project update: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Project{}, err
+	}
+	logger.Log.Infof("project update: success id=%d user_id=%d", id, userID)
+	return project, nil
+}
+
+func (s *ProjectService) Delete(ctx context.Context, token string, id int64) error {
+	if id <= 0 {
+		logger.Log.Infof("project delete: invalid id=%d", id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("project delete: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	if _, err := s.ownedProject(ctx, id, userID); err != nil {
+		logger.Log.Infof("project delete: access error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+
+	if err := s.repo.DeleteByIDAndUserID(ctx, id, userID); err != nil {
+		logger.Log.Infof("project delete: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("project delete: success id=%d user_id=%d", id, userID)
+	return nil
+}
+
+func (s *TaskService) MoveToProject(ctx context.Context, token string, id, version, projectID int64) (domain.Task, error) {
+	if id <= 0 || projectID < 0 {
+		logger.Log.Infof("task move: invalid input id=%d project_id=%d", id, projectID)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task move: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task move: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if current.ParentID != 0 {
+		logger.Log.Infof("task move: subtask id=%d parent_id=%d", id, current.ParentID)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if projectID != 0 {
+		if err := s.checkProject(ctx, projectID, userID); err != nil {
+			logger.Log.Infof("task move: project error project_id=%d user_id=%d err=%v", projectID, userID, err)
+			return domain.Task{}, err
+		}
+	}
+
+	task, err := s.repo.UpdateProjectByIDAndUserID(ctx, id, current.UserID, version, projectID)
+	if err != nil {
+		logger.Log.Infof("task move: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task move: success id=%d user_id=%d project_id=%d", id, userID, projectID)
+	return task, nil
+}
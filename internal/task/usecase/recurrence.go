@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const materializeBatchSize = 500
+
+// MaterializeRecurring creates the next occurrence of every finished recurring
+// task. The next occurrence is the first one due after now: occurrences that
+// fell due while the previous one was still open are skipped rather than
+// created already overdue, and the skipped indexes are never used.
+func (s *TaskService) MaterializeRecurring(ctx context.Context) error {
+	ctx = domain.WithActor(ctx, domain.SchedulerActor())
+	tasks, err := s.repo.GetRecurringWithoutNext(ctx, []domain.TaskStatus{domain.COMPLETED, domain.EXPIRED}, materializeBatchSize)
+	if err != nil {
+		logger.Log.Infof("task materialize recurring: repo error err=%v", err)
+		return err
+	}
+
+	created := 0
+	for _, task := range tasks {
+		ok, err := s.materializeNext(ctx, task)
+		if err != nil {
+			logger.Log.Infof("task materialize recurring: error id=%d err=%v", task.ID, err)
+			return err
+		}
+		if ok {
+			created++
+		}
+	}
+	logger.Log.Infof("task materialize recurring: success checked=%d created=%d", len(tasks), created)
+	return nil
+}
+
+func (s *TaskService) materializeNext(ctx context.Context, task domain.Task) (bool, error) {
+	if task.Recurrence == "" || task.SeriesID == 0 {
+		return false, nil
+	}
+	recurrence, err := domain.ParseRRule(task.Recurrence)
+	if err != nil {
+		logger.Log.Infof("task materialize next: invalid rule id=%d rule=%q", task.ID, task.Recurrence)
+		return false, nil
+	}
+
+	dueDate, occurrence, ok := recurrence.NextAfter(task.SeriesStart, s.now(), task.Occurrence+1)
+	if !ok {
+		logger.Log.Infof("task materialize next: series finished id=%d series_id=%d", task.ID, task.SeriesID)
+		return false, nil
+	}
+	if skipped := occurrence - task.Occurrence - 1; skipped > 0 {
+		logger.Log.Infof("task materialize next: skipped overdue occurrences series_id=%d skipped=%d", task.SeriesID, skipped)
+	}
+
+	next := domain.Task{
+		UserID:      task.UserID,
+		Description: task.Description,
+		Status:      domain.CREATED,
+		CreatedAt:   s.now(),
+		DueDate:     dueDate,
+		Priority:    task.Priority,
+		Tags:        task.Tags,
+		Recurrence:  task.Recurrence,
+		SeriesID:    task.SeriesID,
+		Occurrence:  occurrence,
+		SeriesStart: task.SeriesStart,
+		Reminders:   task.Reminders,
+		ProjectID:   task.ProjectID,
+	}
+	task, err = s.withSubtasks(ctx, task)
+	if err != nil {
+		return false, err
+	}
+
+	var created domain.Task
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		created, ok, err = s.repo.CreateOccurrence(ctx, next)
+		if err != nil || !ok {
+			return err
+		}
+		return s.copySubtasks(ctx, task.Subtasks, created, dueDate.Sub(task.DueDate))
+	})
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		logger.Log.Infof("task materialize next: already exists series_id=%d occurrence=%d", task.SeriesID, occurrence)
+		return false, nil
+	}
+	logger.Log.Infof("task materialize next: created id=%d series_id=%d occurrence=%d", created.ID, task.SeriesID, occurrence)
+	return true, nil
+}
+
+// copySubtasks recreates the subtask tree of a finished occurrence under the
+// next one, as CREATED and with due dates moved by shift.
+func (s *TaskService) copySubtasks(ctx context.Context, subtasks []domain.Task, parent domain.Task, shift time.Duration) error {
+	for _, subtask := range subtasks {
+		created, err := s.repo.Create(ctx, domain.Task{
+			UserID:      parent.UserID,
+			Description: subtask.Description,
+			Status:      domain.CREATED,
+			CreatedAt:   s.now(),
+			DueDate:     subtask.DueDate.Add(shift),
+			Priority:    subtask.Priority,
+			Tags:        subtask.Tags,
+			Reminders:   subtask.Reminders,
+			ParentID:    parent.ID,
+			ProjectID:   parent.ProjectID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.copySubtasks(ctx, subtask.Subtasks, created, shift); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// alignToRecurrence moves due onto the first occurrence of rule on or after
+// it, so that a series never starts on a day its BYDAY leaves out. Due is kept
+// as is when the rule has no occurrence at all.
+func alignToRecurrence(rule string, due time.Time) time.Time {
+	if rule == "" {
+		return due
+	}
+	recurrence, err := domain.ParseRRule(rule)
+	if err != nil {
+		return due
+	}
+	if first, ok := recurrence.Occurrence(due, 0); ok {
+		return first
+	}
+	return due
+}
+
+func normalizeRecurrence(rule string) (string, error) {
+	if rule == "" {
+		return "", nil
+	}
+	recurrence, err := domain.ParseRRule(rule)
+	if err != nil {
+		return "", err
+	}
+	return recurrence.String(), nil
+}
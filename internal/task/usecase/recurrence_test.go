@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlignToRecurrence(t *testing.T) {
+	// A Wednesday.
+	due := time.Date(2026, time.October, 14, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule string
+		want time.Time
+	}{
+		{name: "no rule", rule: "", want: due},
+		{name: "due on a listed day", rule: "FREQ=WEEKLY;BYDAY=WE,FR", want: due},
+		{name: "moved to the next listed day", rule: "FREQ=WEEKLY;BYDAY=MO,FR", want: due.AddDate(0, 0, 2)},
+		{name: "moved to the next week", rule: "FREQ=WEEKLY;BYDAY=MO", want: due.AddDate(0, 0, 5)},
+		{name: "rule without BYDAY", rule: "FREQ=MONTHLY", want: due},
+		{name: "no occurrence before UNTIL", rule: "FREQ=WEEKLY;BYDAY=MO;UNTIL=20261015", want: due},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := alignToRecurrence(tt.rule, due)
+			if !got.Equal(tt.want) {
+				t.Fatalf("alignToRecurrence(%q) = %v, want %v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+// SendDueReminders publishes every reminder that fell due in the last ten
+// minutes. A failed publish does not stop the rest; the failures are returned
+// together.
+func (s *TaskService) SendDueReminders(ctx context.Context) error {
+	now := s.now()
+	from := now.Add(-10 * time.Minute)
+
+	reminders, err := s.repo.GetDueReminders(ctx, from, now, []domain.TaskStatus{domain.CREATED, domain.AT_WORK})
+	if err != nil {
+		logger.Log.Infof("task send due reminders: repo error err=%v", err)
+		return err
+	}
+	if s.events == nil {
+		logger.Log.Infof("task send due reminders: no publisher count=%d", len(reminders))
+		return nil
+	}
+
+	var errs []error
+	for _, reminder := range reminders {
+		if err := s.events.PublishReminder(ctx, reminder); err != nil {
+			logger.Log.Infof("task send due reminders: publish error task_id=%d err=%v", reminder.TaskID, err)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		logger.Log.Infof("task send due reminders: failed count=%d failed=%d", len(reminders), len(errs))
+		return errors.Join(errs...)
+	}
+	logger.Log.Infof("task send due reminders: success count=%d", len(reminders))
+	return nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+// reminderRepository serves fixed due reminders; any other repository call
+// panics on the nil embedded interface.
+type reminderRepository struct {
+	domain.TaskRepository
+	reminders []domain.Reminder
+}
+
+func (r reminderRepository) GetDueReminders(context.Context, time.Time, time.Time, []domain.TaskStatus) ([]domain.Reminder, error) {
+	return r.reminders, nil
+}
+
+// reminderPublisher records published reminders and fails for the task ids
+// in fail.
+type reminderPublisher struct {
+	TaskEventPublisher
+	fail      map[int64]error
+	published []int64
+}
+
+func (p *reminderPublisher) PublishReminder(_ context.Context, reminder domain.Reminder) error {
+	if err := p.fail[reminder.TaskID]; err != nil {
+		return err
+	}
+	p.published = append(p.published, reminder.TaskID)
+	return nil
+}
+
+func TestSendDueReminders(t *testing.T) {
+	errFirst := errors.New("broker down")
+	errThird := errors.New("message too large")
+	reminders := []domain.Reminder{{TaskID: 1}, {TaskID: 2}, {TaskID: 3}}
+
+	tests := []struct {
+		name          string
+		publisher     *reminderPublisher
+		wantPublished []int64
+		wantErrs      []error
+	}{
+		{name: "no publisher"},
+		{
+			name:          "all published",
+			publisher:     &reminderPublisher{},
+			wantPublished: []int64{1, 2, 3},
+		},
+		{
+			name:          "failures do not stop the rest",
+			publisher:     &reminderPublisher{fail: map[int64]error{1: errFirst, 3: errThird}},
+			wantPublished: []int64{2},
+			wantErrs:      []error{errFirst, errThird},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events TaskEventPublisher
+			if tt.publisher != nil {
+				events = tt.publisher
+			}
+			svc := NewTaskService(reminderRepository{reminders: reminders}, nil, nil, nil, nil, fakeTokens{}, events, 0)
+
+			err := svc.SendDueReminders(context.Background())
+			if (err != nil) != (len(tt.wantErrs) > 0) {
+				t.Fatalf("SendDueReminders error = %v, want %v", err, tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Fatalf("SendDueReminders error = %v, want it to include %v", err, want)
+				}
+			}
+			if tt.publisher != nil && !reflect.DeepEqual(tt.publisher.published, tt.wantPublished) {
+				t.Fatalf("published %v, want %v", tt.publisher.published, tt.wantPublished)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) Search(ctx context.Context, token string, filter domain.TaskFilter, pageSize int, pageToken string) ([]domain.SearchHit, string, error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	if filter.Query == "" || len(filter.Query) > domain.MaxSearchQueryLength {
+		logger.Log.Infof("task search: invalid query length=%d", len(filter.Query))
+		return nil, "", ErrInvalidInput
+	}
+	limit, err := normalizePageSize(pageSize)
+	if err != nil {
+		logger.Log.Infof("task search: invalid page size=%d", pageSize)
+		return nil, "", err
+	}
+	offset, err := decodeOffsetToken(pageToken)
+	if err != nil {
+		logger.Log.Infof("task search: invalid page token")
+		return nil, "", err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task search: invalid token err=%v", err)
+		return nil, "", ErrInvalidToken
+	}
+
+	filter, err = s.scopeFilter(ctx, userID, filter)
+	if err != nil {
+		logger.Log.Infof("task search: filter error user_id=%d err=%v", userID, err)
+		return nil, "", err
+	}
+	filter.Limit = limit + 1
+
+	hits, err := s.repo.Search(ctx, filter, offset)
+	if err != nil {
+		logger.Log.Infof("task search: repo error user_id=%d err=%v", userID, err)
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if len(hits) > limit {
+		hits = hits[:limit]
+		nextPageToken = encodeOffsetToken(offset + limit)
+	}
+	logger.Log.Infof("task search: success user_id=%d count=%d", userID, len(hits))
+	return hits, nextPageToken, nil
+}
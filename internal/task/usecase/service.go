@@ -7,6 +7,7 @@ import (
 
 	"task-tracker/internal/task/domain"
 	"task-tracker/pkg/logger"
+	"task-tracker/pkg/timezone"
 )
 
 var (
@@ -16,37 +17,93 @@ var (
 
 type TokenParser interface {
 	ParseUserID(token string) (int64, error)
+	ParseUserIDAndTimeZone(token string) (int64, string, error)
 }
 
 type TaskService struct {
-	repo   domain.TaskRepository
-	tokens TokenParser
-	events TaskEventPublisher
-	now    func() time.Time
+	repo           domain.TaskRepository
+	projects       domain.ProjectRepository
+	comments       domain.CommentRepository
+	timeEntries    domain.TimeEntryRepository
+	templates      domain.TaskTemplateRepository
+	tokens         TokenParser
+	events         TaskEventPublisher
+	now            func() time.Time
+	trashRetention time.Duration
 }
 
-func NewTaskService(repo domain.TaskRepository, tokens TokenParser, events TaskEventPublisher) *TaskService {
-	return &TaskService{repo: repo, tokens: tokens, events: events, now: time.Now}
+func NewTaskService(repo domain.TaskRepository, projects domain.ProjectRepository, comments domain.CommentRepository, timeEntries domain.TimeEntryRepository, templates domain.TaskTemplateRepository, tokens TokenParser, events TaskEventPublisher, trashRetention time.Duration) *TaskService {
+	return &TaskService{repo: repo, projects: projects, comments: comments, timeEntries: timeEntries, templates: templates, tokens: tokens, events: events, now: time.Now, trashRetention: trashRetention}
 }
 
-func (s *TaskService) Create(ctx context.Context, token, description string, dueDate time.Time) (domain.Task, error) {
-	if description == "" || dueDate.IsZero() {
+// Create stores a new task. Its due date is taken from draft.DueDate, or
+// else from dueDay or dueText, both read in the caller's time zone.
+func (s *TaskService) Create(ctx context.Context, token string, draft domain.Task, dueDay, dueText string) (domain.Task, error) {
+	if draft.Description == "" || (draft.DueDate.IsZero() && dueDay == "" && dueText == "") || !draft.Priority.Valid() {
 		logger.Log.Infof("task create: invalid input")
 		return domain.Task{}, ErrInvalidInput
 	}
+	if dueText != "" && (!draft.DueDate.IsZero() || dueDay != "") {
+		logger.Log.Infof("task create: due text combined with due date")
+		return domain.Task{}, ErrInvalidInput
+	}
+	tags, err := domain.NormalizeTags(draft.Tags)
+	if err != nil {
+		logger.Log.Infof("task create: invalid tags err=%v", err)
+		return domain.Task{}, ErrInvalidInput
+	}
+	recurrence, err := normalizeRecurrence(draft.Recurrence)
+	if err != nil {
+		logger.Log.Infof("task create: invalid recurrence err=%v", err)
+		return domain.Task{}, ErrInvalidInput
+	}
+	reminders, err := domain.NormalizeReminders(draft.Reminders)
+	if err != nil {
+		logger.Log.Infof("task create: invalid reminders err=%v", err)
+		return domain.Task{}, ErrInvalidInput
+	}
 
-	userID, err := s.tokens.ParseUserID(token)
+	userID, loc, err := s.userLocation(token)
 	if err != nil {
 		logger.Log.Infof("task create: invalid token err=%v", err)
 		return domain.Task{}, ErrInvalidToken
 	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	switch {
+	case dueText != "":
+		draft.DueDate, err = domain.ParseDueText(dueText, s.now().In(loc))
+		if err != nil {
+			logger.Log.Infof("task create: invalid due text=%q err=%v", dueText, err)
+			return domain.Task{}, err
+		}
+		logger.Log.Infof("task create: resolved due text=%q due_date=%s", dueText, draft.DueDate.Format(time.RFC3339))
+	case draft.DueDate.IsZero():
+		draft.DueDate, err = endOfDay(dueDay, loc)
+		if err != nil {
+			logger.Log.Infof("task create: invalid due day=%q err=%v", dueDay, err)
+			return domain.Task{}, ErrInvalidInput
+		}
+	}
+	draft.DueDate = alignToRecurrence(recurrence, draft.DueDate)
+	if draft.ProjectID != 0 {
+		if err := s.checkProject(ctx, draft.ProjectID, userID); err != nil {
+			logger.Log.Infof("task create: project error project_id=%d user_id=%d err=%v", draft.ProjectID, userID, err)
+			return domain.Task{}, err
+		}
+	}
 
 	task := domain.Task{
 		UserID:      userID,
-		Description: description,
+		Description: draft.Description,
 		Status:      domain.CREATED,
 		CreatedAt:   s.now(),
-		DueDate:     dueDate,
+		DueDate:     draft.DueDate,
+		Priority:    draft.Priority,
+		Tags:        tags,
+		Recurrence:  recurrence,
+		Reminders:   reminders,
+		ProjectID:   draft.ProjectID,
 	}
 	created, err := s.repo.Create(ctx, task)
 	if err != nil {
@@ -69,26 +126,93 @@ func (s *TaskService) GetByID(ctx context.Context, token string, id int64) (doma
 		return domain.Task{}, ErrInvalidToken
 	}
 
-	task, err := s.repo.GetByIDAndUserID(ctx, id, userID)
+	task, err := s.authorizeTask(ctx, id, userID, accessRead)
 	if err != nil {
-		logger.Log.Infof("task get by id: repo error id=%d user_id=%d err=%v", id, userID, err)
+		logger.Log.Infof("task get by id: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	task, err = s.withSubtasks(ctx, task)
+	if err != nil {
+		logger.Log.Infof("task get by id: subtasks error id=%d user_id=%d err=%v", id, userID, err)
 		return domain.Task{}, err
 	}
 	logger.Log.Infof("task get by id: success id=%d user_id=%d", id, userID)
 	return task, nil
 }
 
-func (s *TaskService) GetToday(ctx context.Context, token string) ([]domain.Task, error) {
+func (s *TaskService) List(ctx context.Context, token string, filter domain.TaskFilter, pageSize int, pageToken string) ([]domain.Task, string, error) {
+	limit, err := normalizePageSize(pageSize)
+	if err != nil {
+		logger.Log.Infof("task list: invalid page size=%d", pageSize)
+		return nil, "", err
+	}
+	cursor, err := decodePageToken(pageToken, filter.Sort)
+	if err != nil {
+		logger.Log.Infof("task list: invalid page token")
+		return nil, "", err
+	}
+
 	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list: invalid token err=%v", err)
+		return nil, "", ErrInvalidToken
+	}
+
+	filter, err = s.scopeFilter(ctx, userID, filter)
+	if err != nil {
+		logger.Log.Infof("task list: filter error user_id=%d err=%v", userID, err)
+		return nil, "", err
+	}
+	filter.After = cursor
+	filter.Limit = limit + 1
+
+	tasks, err := s.repo.List(ctx, filter)
+	if err != nil {
+		logger.Log.Infof("task list: repo error user_id=%d err=%v", userID, err)
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+		nextPageToken = encodePageToken(filter.Sort, filter.Sort.CursorOf(tasks[limit-1]))
+	}
+	logger.Log.Infof("task list: success user_id=%d count=%d", userID, len(tasks))
+	return tasks, nextPageToken, nil
+}
+
+// scopeFilter restricts filter to the tasks userID may see: their own tasks,
+// tasks assigned to them, or tasks of a project they are a member of.
+func (s *TaskService) scopeFilter(ctx context.Context, userID int64, filter domain.TaskFilter) (domain.TaskFilter, error) {
+	tags, err := domain.NormalizeTags(filter.Tags)
+	if err != nil {
+		return domain.TaskFilter{}, ErrInvalidInput
+	}
+
+	filter.UserID = userID
+	if filter.AssignedToMe {
+		filter.AssigneeID = userID
+		filter.UserID = 0
+	}
+	if filter.ProjectID != 0 {
+		if _, err := s.projects.GetByIDAndMemberID(ctx, filter.ProjectID, userID); err != nil {
+			return domain.TaskFilter{}, err
+		}
+		filter.UserID = 0
+	}
+	filter.Tags = tags
+	return filter, nil
+}
+
+func (s *TaskService) GetToday(ctx context.Context, token string) ([]domain.Task, error) {
+	userID, loc, err := s.userLocation(token)
 	if err != nil {
 		logger.Log.Infof("task get today: invalid token err=%v", err)
 		return nil, ErrInvalidToken
 	}
 
-	now := s.now()
-	loc := now.Location()
-	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-	end := start.Add(24 * time.Hour)
+	start := timezone.StartOfDay(s.now().In(loc))
+	end := start.AddDate(0, 0, 1)
 
 	tasks, err := s.repo.GetByUserIDAndDueDateBetween(ctx, userID, start, end)
 	if err != nil {
@@ -113,6 +237,7 @@ func (s *TaskService) GetRecentExpired(ctx context.Context) ([]domain.Task, erro
 }
 
 func (s *TaskService) ProcessRecentExpired(ctx context.Context) error {
+	ctx = domain.WithActor(ctx, domain.SchedulerActor())
 	now := s.now()
 	from := now.Add(-10 * time.Minute)
 
@@ -129,7 +254,7 @@ func (s *TaskService) ProcessRecentExpired(ctx context.Context) error {
 	}
 
 	counts := make(map[int64]*UserExpiredSummary)
-	var toExpire []int64
+	toExpire := make(map[int64][]int64)
 	for _, task := range tasks {
 		stats, ok := counts[task.UserID]
 		if !ok {
@@ -137,11 +262,10 @@ func (s *TaskService) ProcessRecentExpired(ctx context.Context) error {
 			counts[task.UserID] = stats
 		}
 
-		if task.Status == domain.COMPLETED {
-			stats.Completed++
-		} else {
-			stats.NotCompleted++
-			toExpire = append(toExpire, task.ID)
+		completed := task.Status == domain.COMPLETED
+		stats.add(task.Priority, completed)
+		if !completed {
+			toExpire[task.UserID] = append(toExpire[task.UserID], task.ID)
 		}
 	}
 
@@ -149,9 +273,11 @@ func (s *TaskService) ProcessRecentExpired(ctx context.Context) error {
 		summary.Users = append(summary.Users, *stats)
 	}
 
-	if err := s.repo.UpdateStatusByIDs(ctx, toExpire, domain.EXPIRED); err != nil {
-		logger.Log.Infof("task process recent expired: update status error count=%d err=%v", len(toExpire), err)
-		return err
+	for userID, ids := range toExpire {
+		if err := s.repo.UpdateStatusByIDsAndUserID(ctx, ids, userID, domain.AllowedFrom(domain.EXPIRED), domain.EXPIRED); err != nil {
+			logger.Log.Infof("task process recent expired: update status error user_id=%d count=%d err=%v", userID, len(ids), err)
+			return err
+		}
 	}
 
 	if s.events == nil {
@@ -166,13 +292,13 @@ func (s *TaskService) ProcessRecentExpired(ctx context.Context) error {
 	return nil
 }
 
-func (s *TaskService) UpdateStatus(ctx context.Context, token string, id int64, status domain.TaskStatus) (domain.Task, error) {
+func (s *TaskService) UpdateStatus(ctx context.Context, token string, id, version int64, change domain.StatusChange) (domain.Task, error) {
 	if id <= 0 {
 		logger.Log.Infof("task update status: invalid id=%d", id)
 		return domain.Task{}, ErrInvalidInput
 	}
-	if status != domain.CREATED && status != domain.AT_WORK && status != domain.COMPLETED {
-		logger.Log.Infof("task update status: invalid status=%v", status)
+	if change.To != domain.CREATED && change.To != domain.AT_WORK && change.To != domain.COMPLETED {
+		logger.Log.Infof("task update status: invalid status=%v", change.To)
 		return domain.Task{}, ErrInvalidInput
 	}
 
@@ -181,12 +307,199 @@ func (s *TaskService) UpdateStatus(ctx context.Context, token string, id int64,
 		logger.Log.Infof("task update status: invalid token err=%v", err)
 		return domain.Task{}, ErrInvalidToken
 	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
 
-	task, err := s.repo.UpdateStatusByIDAndUserID(ctx, id, userID, status)
+	current, err := s.authorizeTask(ctx, id, userID, accessStatus)
+	if err != nil {
+		logger.Log.Infof("task update status: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if err := current.CheckVersion(version); err != nil {
+		logger.Log.Infof("task update status: version mismatch id=%d expected=%d actual=%d", id, version, current.Version)
+		return domain.Task{}, err
+	}
+	if err := s.checkStatusChange(ctx, current, change); err != nil {
+		return domain.Task{}, err
+	}
+
+	task, err := s.repo.UpdateStatusByIDAndUserID(ctx, id, current.UserID, version, current.Status, change)
 	if err != nil {
 		logger.Log.Infof("task update status: repo error id=%d user_id=%d err=%v", id, userID, err)
 		return domain.Task{}, err
 	}
-	logger.Log.Infof("task update status: success id=%d user_id=%d status=%v", id, userID, status)
+	logger.Log.Infof("task update status: success id=%d user_id=%d status=%v", id, userID, change.To)
+
+	s.afterStatusChange(ctx, task, userID, change)
 	return task, nil
 }
+
+// checkStatusChange rejects a status change that the task cannot make right
+// now: an invalid transition, open subtasks or open blockers.
+func (s *TaskService) checkStatusChange(ctx context.Context, current domain.Task, change domain.StatusChange) error {
+	if err := domain.ValidateTransition(current.Status, change, s.now()); err != nil {
+		logger.Log.Infof("task status change: invalid transition id=%d from=%v to=%v", current.ID, current.Status, change.To)
+		return err
+	}
+	if err := s.checkCompletion(ctx, current, change); err != nil {
+		return err
+	}
+	if change.To == domain.AT_WORK && current.Blocked() {
+		logger.Log.Infof("task status change: blocked id=%d open_blockers=%d", current.ID, current.OpenBlockers)
+		return domain.ErrTaskBlocked
+	}
+	return nil
+}
+
+// afterStatusChange runs the side effects of a stored status change. They
+// are best effort: failures are only logged.
+func (s *TaskService) afterStatusChange(ctx context.Context, task domain.Task, userID int64, change domain.StatusChange) {
+	afterCommit(ctx, func(ctx context.Context) {
+		if task.Status == domain.AT_WORK && change.StartTimer {
+			if _, err := s.startTimer(ctx, task.ID, userID, ""); err != nil {
+				logger.Log.Infof("task status change: start timer error id=%d user_id=%d err=%v", task.ID, userID, err)
+			}
+		}
+		if task.Status == domain.COMPLETED {
+			if _, err := s.materializeNext(ctx, task); err != nil {
+				logger.Log.Infof("task status change: materialize next error id=%d err=%v", task.ID, err)
+			}
+			s.stopTimers(ctx, task)
+			s.notifyDependents(ctx, task)
+		}
+	})
+}
+
+type sideEffectsKey struct{}
+
+// deferredSideEffects collects the side effects of status changes made
+// inside a transaction, so that they run only once it has been committed: a
+// failing side effect must not abort the transaction, and the timers and
+// occurrences it writes must not be rolled back with it.
+type deferredSideEffects struct {
+	effects []func(ctx context.Context)
+}
+
+func deferSideEffects(ctx context.Context) (context.Context, *deferredSideEffects) {
+	pending := &deferredSideEffects{}
+	return context.WithValue(ctx, sideEffectsKey{}, pending), pending
+}
+
+func (d *deferredSideEffects) run(ctx context.Context) {
+	for _, effect := range d.effects {
+		effect(ctx)
+	}
+}
+
+// afterCommit runs effect now, or queues it when ctx carries side effects
+// deferred by deferSideEffects.
+func afterCommit(ctx context.Context, effect func(ctx context.Context)) {
+	if pending, ok := ctx.Value(sideEffectsKey{}).(*deferredSideEffects); ok {
+		pending.effects = append(pending.effects, effect)
+		return
+	}
+	effect(ctx)
+}
+
+func (s *TaskService) Update(ctx context.Context, token string, id, version int64, update domain.TaskUpdate) (domain.Task, error) {
+	if id <= 0 {
+		logger.Log.Infof("task update: invalid id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if update.IsEmpty() {
+		logger.Log.Infof("task update: empty update id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if update.Description != nil && *update.Description == "" {
+		logger.Log.Infof("task update: empty description id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if update.DueDate != nil && update.DueDate.IsZero() {
+		logger.Log.Infof("task update: empty due date id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if update.Priority != nil && !update.Priority.Valid() {
+		logger.Log.Infof("task update: invalid priority id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if update.Tags != nil {
+		tags, err := domain.NormalizeTags(*update.Tags)
+		if err != nil {
+			logger.Log.Infof("task update: invalid tags id=%d err=%v", id, err)
+			return domain.Task{}, ErrInvalidInput
+		}
+		update.Tags = &tags
+	}
+	if update.Recurrence != nil {
+		recurrence, err := normalizeRecurrence(*update.Recurrence)
+		if err != nil {
+			logger.Log.Infof("task update: invalid recurrence id=%d err=%v", id, err)
+			return domain.Task{}, ErrInvalidInput
+		}
+		update.Recurrence = &recurrence
+	}
+	if update.Reminders != nil {
+		reminders, err := domain.NormalizeReminders(*update.Reminders)
+		if err != nil {
+			logger.Log.Infof("task update: invalid reminders id=%d err=%v", id, err)
+			return domain.Task{}, ErrInvalidInput
+		}
+		update.Reminders = &reminders
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task update: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task update: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if update.Recurrence != nil && current.SeriesID == 0 {
+		due := current.DueDate
+		if update.DueDate != nil {
+			due = *update.DueDate
+		}
+		if aligned := alignToRecurrence(*update.Recurrence, due); !aligned.Equal(due) {
+			update.DueDate = &aligned
+		}
+	}
+
+	task, err := s.repo.UpdateByIDAndUserID(ctx, id, current.UserID, version, update)
+	if err != nil {
+		logger.Log.Infof("task update: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task update: success id=%d user_id=%d", id, userID)
+	return task, nil
+}
+
+func (s *TaskService) Delete(ctx context.Context, token string, id, version int64) error {
+	if id <= 0 {
+		logger.Log.Infof("task delete: invalid id=%d", id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task delete: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task delete: access error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+
+	if err := s.repo.DeleteByIDAndUserID(ctx, id, current.UserID, version, s.now()); err != nil {
+		logger.Log.Infof("task delete: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("task delete: success id=%d user_id=%d", id, userID)
+	return nil
+}
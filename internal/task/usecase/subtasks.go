@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) AddSubtask(ctx context.Context, token string, parentID int64, draft domain.Task) (domain.Task, error) {
+	if parentID <= 0 || draft.Description == "" || !draft.Priority.Valid() {
+		logger.Log.Infof("task add subtask: invalid input parent_id=%d", parentID)
+		return domain.Task{}, ErrInvalidInput
+	}
+	tags, err := domain.NormalizeTags(draft.Tags)
+	if err != nil {
+		logger.Log.Infof("task add subtask: invalid tags err=%v", err)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task add subtask: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	parent, err := s.authorizeTask(ctx, parentID, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task add subtask: parent error parent_id=%d user_id=%d err=%v", parentID, userID, err)
+		return domain.Task{}, err
+	}
+	if parent.Status == domain.COMPLETED || parent.Status == domain.EXPIRED {
+		logger.Log.Infof("task add subtask: parent closed parent_id=%d status=%v", parentID, parent.Status)
+		return domain.Task{}, domain.ErrInvalidTransition
+	}
+
+	dueDate := draft.DueDate
+	if dueDate.IsZero() {
+		dueDate = parent.DueDate
+	}
+	task := domain.Task{
+		UserID:      parent.UserID,
+		Description: draft.Description,
+		Status:      domain.CREATED,
+		CreatedAt:   s.now(),
+		DueDate:     dueDate,
+		Priority:    draft.Priority,
+		Tags:        tags,
+		ParentID:    parent.ID,
+		ProjectID:   parent.ProjectID,
+	}
+	created, err := s.repo.Create(ctx, task)
+	if err != nil {
+		logger.Log.Infof("task add subtask: repo error parent_id=%d user_id=%d err=%v", parentID, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task add subtask: success id=%d parent_id=%d user_id=%d", created.ID, parentID, userID)
+	return created, nil
+}
+
+func (s *TaskService) ReorderSubtasks(ctx context.Context, token string, parentID int64, ids []int64) ([]domain.Task, error) {
+	if parentID <= 0 || len(ids) == 0 {
+		logger.Log.Infof("task reorder subtasks: invalid input parent_id=%d", parentID)
+		return nil, ErrInvalidInput
+	}
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id <= 0 {
+			logger.Log.Infof("task reorder subtasks: invalid id=%d parent_id=%d", id, parentID)
+			return nil, ErrInvalidInput
+		}
+		seen[id] = struct{}{}
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task reorder subtasks: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, parentID, userID, accessWrite); err != nil {
+		logger.Log.Infof("task reorder subtasks: parent error parent_id=%d user_id=%d err=%v", parentID, userID, err)
+		return nil, err
+	}
+	if err := s.repo.ReorderByParentID(ctx, parentID, ids); err != nil {
+		logger.Log.Infof("task reorder subtasks: repo error parent_id=%d user_id=%d err=%v", parentID, userID, err)
+		return nil, err
+	}
+
+	subtasks, err := s.repo.GetByParentIDs(ctx, []int64{parentID})
+	if err != nil {
+		logger.Log.Infof("task reorder subtasks: reload error parent_id=%d err=%v", parentID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task reorder subtasks: success parent_id=%d user_id=%d count=%d", parentID, userID, len(subtasks))
+	return subtasks, nil
+}
+
+func (s *TaskService) ToggleSubtask(ctx context.Context, token string, id, version int64) (domain.Task, error) {
+	if id <= 0 {
+		logger.Log.Infof("task toggle subtask: invalid id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task toggle subtask: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeTask(ctx, id, userID, accessStatus)
+	if err != nil {
+		logger.Log.Infof("task toggle subtask: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	if current.ParentID == 0 {
+		logger.Log.Infof("task toggle subtask: not a subtask id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+	if err := current.CheckVersion(version); err != nil {
+		logger.Log.Infof("task toggle subtask: version mismatch id=%d expected=%d actual=%d", id, version, current.Version)
+		return domain.Task{}, err
+	}
+
+	change := domain.StatusChange{To: domain.COMPLETED}
+	if current.Status == domain.COMPLETED {
+		parent, err := s.repo.GetByIDAndUserID(ctx, current.ParentID, current.UserID)
+		if err != nil {
+			logger.Log.Infof("task toggle subtask: parent error parent_id=%d err=%v", current.ParentID, err)
+			return domain.Task{}, err
+		}
+		if parent.Status == domain.COMPLETED {
+			logger.Log.Infof("task toggle subtask: parent completed parent_id=%d", parent.ID)
+			return domain.Task{}, domain.ErrInvalidTransition
+		}
+		change = domain.StatusChange{To: domain.CREATED, Reopen: true}
+	}
+	if err := s.checkCompletion(ctx, current, change); err != nil {
+		return domain.Task{}, err
+	}
+	if err := domain.ValidateTransition(current.Status, change, s.now()); err != nil {
+		logger.Log.Infof("task toggle subtask: invalid transition id=%d from=%v to=%v", id, current.Status, change.To)
+		return domain.Task{}, err
+	}
+
+	task, err := s.repo.UpdateStatusByIDAndUserID(ctx, id, current.UserID, version, current.Status, change)
+	if err != nil {
+		logger.Log.Infof("task toggle subtask: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task toggle subtask: success id=%d user_id=%d status=%v", id, userID, task.Status)
+
+	if task.Status == domain.COMPLETED {
+		afterCommit(ctx, func(ctx context.Context) {
+			s.stopTimers(ctx, task)
+			s.notifyDependents(ctx, task)
+		})
+	}
+	return task, nil
+}
+
+func (s *TaskService) checkCompletion(ctx context.Context, task domain.Task, change domain.StatusChange) error {
+	if change.To != domain.COMPLETED {
+		return nil
+	}
+	incomplete, err := s.repo.CountIncompleteByParentID(ctx, task.ID)
+	if err != nil {
+		logger.Log.Infof("task check completion: repo error id=%d err=%v", task.ID, err)
+		return err
+	}
+	if incomplete > 0 {
+		logger.Log.Infof("task check completion: incomplete subtasks id=%d count=%d", task.ID, incomplete)
+		return domain.ErrIncompleteSubtasks
+	}
+	return nil
+}
+
+func (s *TaskService) withSubtasks(ctx context.Context, task domain.Task) (domain.Task, error) {
+	children := make(map[int64][]domain.Task)
+	parentIDs := []int64{task.ID}
+	for len(parentIDs) > 0 {
+		subtasks, err := s.repo.GetByParentIDs(ctx, parentIDs)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		parentIDs = parentIDs[:0]
+		for _, subtask := range subtasks {
+			children[subtask.ParentID] = append(children[subtask.ParentID], subtask)
+			parentIDs = append(parentIDs, subtask.ID)
+		}
+	}
+	return buildTree(task, children), nil
+}
+
+func buildTree(task domain.Task, children map[int64][]domain.Task) domain.Task {
+	for _, child := range children[task.ID] {
+		task.Subtasks = append(task.Subtasks, buildTree(child, children))
+	}
+	return task
+}
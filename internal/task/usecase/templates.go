@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) CreateTemplate(ctx context.Context, token, name string, items []domain.TemplateItem) (domain.TaskTemplate, error) {
+	name, items, err := normalizeTemplate(name, items)
+	if err != nil {
+		logger.Log.Infof("task create template: invalid template err=%v", err)
+		return domain.TaskTemplate{}, err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task create template: invalid token err=%v", err)
+		return domain.TaskTemplate{}, ErrInvalidToken
+	}
+
+	template, err := s.templates.Create(ctx, domain.TaskTemplate{UserID: userID, Name: name, Items: items, CreatedAt: s.now()})
+	if err != nil {
+		logger.Log.Infof("task create template: repo error user_id=%d err=%v", userID, err)
+		return domain.TaskTemplate{}, err
+	}
+	logger.Log.Infof("task create template: success id=%d user_id=%d items=%d", template.ID, userID, template.ItemCount)
+	return template, nil
+}
+
+// SaveAsTemplate captures a task with its subtasks, or every task of a
+// project, as a template. Due offsets are counted in the caller's calendar
+// days from the earliest top-level task. An empty name falls back to the
+// task description or the project name.
+func (s *TaskService) SaveAsTemplate(ctx context.Context, token, name string, taskID, projectID int64) (domain.TaskTemplate, error) {
+	if (taskID == 0) == (projectID == 0) || taskID < 0 || projectID < 0 {
+		logger.Log.Infof("task save template: invalid source task_id=%d project_id=%d", taskID, projectID)
+		return domain.TaskTemplate{}, ErrInvalidInput
+	}
+
+	userID, loc, err := s.userLocation(token)
+	if err != nil {
+		logger.Log.Infof("task save template: invalid token err=%v", err)
+		return domain.TaskTemplate{}, ErrInvalidToken
+	}
+
+	var roots []domain.Task
+	if taskID != 0 {
+		task, err := s.authorizeTask(ctx, taskID, userID, accessRead)
+		if err != nil {
+			logger.Log.Infof("task save template: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+			return domain.TaskTemplate{}, err
+		}
+		task, err = s.withSubtasks(ctx, task)
+		if err != nil {
+			logger.Log.Infof("task save template: subtasks error task_id=%d err=%v", taskID, err)
+			return domain.TaskTemplate{}, err
+		}
+		roots = []domain.Task{task}
+		if name == "" {
+			name = task.Description
+		}
+	} else {
+		project, err := s.projects.GetByIDAndMemberID(ctx, projectID, userID)
+		if err != nil {
+			logger.Log.Infof("task save template: access error project_id=%d user_id=%d err=%v", projectID, userID, err)
+			return domain.TaskTemplate{}, err
+		}
+		roots, err = s.projectTrees(ctx, projectID)
+		if err != nil {
+			logger.Log.Infof("task save template: tasks error project_id=%d err=%v", projectID, err)
+			return domain.TaskTemplate{}, err
+		}
+		if name == "" {
+			name = project.Name
+		}
+	}
+	if len(roots) == 0 {
+		logger.Log.Infof("task save template: no tasks task_id=%d project_id=%d", taskID, projectID)
+		return domain.TaskTemplate{}, ErrInvalidInput
+	}
+
+	start := roots[0].DueDate
+	for _, root := range roots {
+		if root.DueDate.Before(start) {
+			start = root.DueDate
+		}
+	}
+	items := make([]domain.TemplateItem, 0, len(roots))
+	for _, root := range roots {
+		items = append(items, templateItemOf(root, start, loc))
+	}
+	if len([]rune(name)) > domain.MaxTemplateNameLength {
+		name = string([]rune(name)[:domain.MaxTemplateNameLength])
+	}
+	return s.CreateTemplate(ctx, token, name, items)
+}
+
+// projectTrees returns the top-level tasks of a project, earliest due first,
+// with their subtasks attached.
+func (s *TaskService) projectTrees(ctx context.Context, projectID int64) ([]domain.Task, error) {
+	tasks, err := s.repo.List(ctx, domain.TaskFilter{ProjectID: projectID, Sort: domain.SORT_DUE_DATE_ASC, Limit: domain.MaxTemplateItems + 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) > domain.MaxTemplateItems {
+		return nil, ErrInvalidInput
+	}
+
+	ids := make(map[int64]struct{}, len(tasks))
+	for _, task := range tasks {
+		ids[task.ID] = struct{}{}
+	}
+	var roots []domain.Task
+	children := make(map[int64][]domain.Task)
+	for _, task := range tasks {
+		if _, ok := ids[task.ParentID]; ok {
+			children[task.ParentID] = append(children[task.ParentID], task)
+		} else {
+			roots = append(roots, task)
+		}
+	}
+	for _, siblings := range children {
+		slices.SortStableFunc(siblings, func(a, b domain.Task) int { return a.Position - b.Position })
+	}
+
+	trees := make([]domain.Task, 0, len(roots))
+	for _, root := range roots {
+		trees = append(trees, buildTree(root, children))
+	}
+	return trees, nil
+}
+
+func templateItemOf(task domain.Task, start time.Time, loc *time.Location) domain.TemplateItem {
+	item := domain.TemplateItem{
+		Description:   task.Description,
+		DueOffsetDays: daysBetween(start, task.DueDate, loc),
+		Priority:      task.Priority,
+		Tags:          task.Tags,
+	}
+	for _, subtask := range task.Subtasks {
+		item.Subtasks = append(item.Subtasks, templateItemOf(subtask, start, loc))
+	}
+	return item
+}
+
+// daysBetween counts calendar days in loc from the day of from to the day of to.
+func daysBetween(from, to time.Time, loc *time.Location) int {
+	fromYear, fromMonth, fromDay := from.In(loc).Date()
+	toYear, toMonth, toDay := to.In(loc).Date()
+	days := time.Date(toYear, toMonth, toDay, 0, 0, 0, 0, time.UTC).Sub(time.Date(fromYear, fromMonth, fromDay, 0, 0, 0, 0, time.UTC))
+	return int(days / (24 * time.Hour))
+}
+
+func (s *TaskService) ListTemplates(ctx context.Context, token string) ([]domain.TaskTemplate, error) {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list templates: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	templates, err := s.templates.GetByUserID(ctx, userID)
+	if err != nil {
+		logger.Log.Infof("task list templates: repo error user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task list templates: success user_id=%d count=%d", userID, len(templates))
+	return templates, nil
+}
+
+func (s *TaskService) GetTemplate(ctx context.Context, token string, id int64) (domain.TaskTemplate, error) {
+	if id <= 0 {
+		logger.Log.Infof("task get template: invalid id=%d", id)
+		return domain.TaskTemplate{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task get template: invalid token err=%v", err)
+		return domain.TaskTemplate{}, ErrInvalidToken
+	}
+
+	template, err := s.templates.GetByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		logger.Log.Infof("task get template: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.TaskTemplate{}, err
+	}
+	logger.Log.Infof("task get template: success id=%d user_id=%d", id, userID)
+	return template, nil
+}
+
+func (s *TaskService) DeleteTemplate(ctx context.Context, token string, id int64) error {
+	if id <= 0 {
+		logger.Log.Infof("task delete template: invalid id=%d", id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task delete template: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+
+	if err := s.templates.DeleteByIDAndUserID(ctx, id, userID); err != nil {
+		logger.Log.Infof("task delete template: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("task delete template: success id=%d user_id=%d", id, userID)
+	return nil
+}
+
+// InstantiateTemplate creates the tasks of a template in one transaction,
+// each due at the end of the day that lies its offset after startDay. The
+// tasks go to projectID when it is set.
+func (s *TaskService) InstantiateTemplate(ctx context.Context, token string, id int64, startDay string, projectID int64) ([]domain.Task, error) {
+	if id <= 0 || projectID < 0 {
+		logger.Log.Infof("task instantiate template: invalid input id=%d project_id=%d", id, projectID)
+		return nil, ErrInvalidInput
+	}
+
+	userID, loc, err := s.userLocation(token)
+	if err != nil {
+		logger.Log.Infof("task instantiate template: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	start, err := time.ParseInLocation(dueDayLayout, startDay, loc)
+	if err != nil {
+		logger.Log.Infof("task instantiate template: invalid start day=%q err=%v", startDay, err)
+		return nil, ErrInvalidInput
+	}
+	template, err := s.templates.GetByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		logger.Log.Infof("task instantiate template: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return nil, err
+	}
+	if projectID != 0 {
+		if err := s.checkProject(ctx, projectID, userID); err != nil {
+			logger.Log.Infof("task instantiate template: project error project_id=%d user_id=%d err=%v", projectID, userID, err)
+			return nil, err
+		}
+	}
+
+	var created []domain.Task
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		created, err = s.createFromItems(ctx, template.Items, domain.Task{UserID: userID, ProjectID: projectID}, start)
+		return err
+	})
+	if err != nil {
+		logger.Log.Infof("task instantiate template: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task instantiate template: success id=%d user_id=%d tasks=%d", id, userID, template.ItemCount)
+	return created, nil
+}
+
+func (s *TaskService) createFromItems(ctx context.Context, items []domain.TemplateItem, parent domain.Task, start time.Time) ([]domain.Task, error) {
+	tasks := make([]domain.Task, 0, len(items))
+	for _, item := range items {
+		task, err := s.repo.Create(ctx, domain.Task{
+			UserID:      parent.UserID,
+			Description: item.Description,
+			Status:      domain.CREATED,
+			CreatedAt:   s.now(),
+			DueDate:     start.AddDate(0, 0, item.DueOffsetDays+1).Add(-time.Second),
+			Priority:    item.Priority,
+			Tags:        item.Tags,
+			ParentID:    parent.ID,
+			ProjectID:   parent.ProjectID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		task.Subtasks, err = s.createFromItems(ctx, item.Subtasks, task, start)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func normalizeTemplate(name string, items []domain.TemplateItem) (string, []domain.TemplateItem, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > domain.MaxTemplateNameLength {
+		return "", nil, ErrInvalidInput
+	}
+	if count := domain.CountItems(items); count == 0 || count > domain.MaxTemplateItems {
+		return "", nil, ErrInvalidInput
+	}
+	items, err := normalizeTemplateItems(items)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, items, nil
+}
+
+func normalizeTemplateItems(items []domain.TemplateItem) ([]domain.TemplateItem, error) {
+	normalized := make([]domain.TemplateItem, 0, len(items))
+	for _, item := range items {
+		item.Description = strings.TrimSpace(item.Description)
+		if item.Description == "" || !item.Priority.Valid() {
+			return nil, ErrInvalidInput
+		}
+		if item.DueOffsetDays < -domain.MaxDueOffsetDays || item.DueOffsetDays > domain.MaxDueOffsetDays {
+			return nil, ErrInvalidInput
+		}
+		tags, err := domain.NormalizeTags(item.Tags)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		item.Tags = tags
+		if item.Subtasks, err = normalizeTemplateItems(item.Subtasks); err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, item)
+	}
+	return normalized, nil
+}
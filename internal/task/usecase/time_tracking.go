@@ -0,0 +1,335 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) StartTimer(ctx context.Context, token string, taskID int64, note string) (domain.TimeEntry, error) {
+	note, ok := normalizeTimeEntryNote(note)
+	if taskID <= 0 || !ok {
+		logger.Log.Infof("task start timer: invalid input task_id=%d", taskID)
+		return domain.TimeEntry{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task start timer: invalid token err=%v", err)
+		return domain.TimeEntry{}, ErrInvalidToken
+	}
+
+	task, err := s.authorizeTask(ctx, taskID, userID, accessStatus)
+	if err != nil {
+		logger.Log.Infof("task start timer: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	if task.Status == domain.COMPLETED || task.Status == domain.EXPIRED {
+		logger.Log.Infof("task start timer: task closed task_id=%d status=%v", taskID, task.Status)
+		return domain.TimeEntry{}, domain.ErrInvalidTransition
+	}
+
+	entry, err := s.startTimer(ctx, taskID, userID, note)
+	if err != nil {
+		logger.Log.Infof("task start timer: repo error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	logger.Log.Infof("task start timer: success id=%d task_id=%d user_id=%d", entry.ID, taskID, userID)
+	return entry, nil
+}
+
+// startTimer starts tracking taskID for userID, stopping the timer the user
+// may have running on another task. A timer already running on taskID is
+// returned as is.
+func (s *TaskService) startTimer(ctx context.Context, taskID, userID int64, note string) (domain.TimeEntry, error) {
+	running, err := s.timeEntries.GetRunningByUserID(ctx, userID)
+	switch {
+	case err == nil && running.TaskID == taskID:
+		return running, nil
+	case err != nil && !errors.Is(err, domain.ErrNotFound):
+		return domain.TimeEntry{}, err
+	}
+
+	now := s.now()
+	return s.timeEntries.Start(ctx, domain.TimeEntry{
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: now,
+		Note:      note,
+		CreatedAt: now,
+	})
+}
+
+func (s *TaskService) StopTimer(ctx context.Context, token string, taskID int64) (domain.TimeEntry, error) {
+	if taskID <= 0 {
+		logger.Log.Infof("task stop timer: invalid task_id=%d", taskID)
+		return domain.TimeEntry{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task stop timer: invalid token err=%v", err)
+		return domain.TimeEntry{}, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task stop timer: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	entry, err := s.timeEntries.StopRunningByTaskIDAndUserID(ctx, taskID, userID, s.now())
+	if err != nil {
+		logger.Log.Infof("task stop timer: repo error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	logger.Log.Infof("task stop timer: success id=%d task_id=%d user_id=%d", entry.ID, taskID, userID)
+	return entry, nil
+}
+
+// stopTimers stops every timer running on a task that has just been
+// completed. Failures are only logged: the status change is already stored.
+func (s *TaskService) stopTimers(ctx context.Context, task domain.Task) {
+	stopped, err := s.timeEntries.StopRunningByTaskID(ctx, task.ID, s.now())
+	if err != nil {
+		logger.Log.Infof("task stop timers: repo error task_id=%d err=%v", task.ID, err)
+		return
+	}
+	if stopped > 0 {
+		logger.Log.Infof("task stop timers: success task_id=%d count=%d", task.ID, stopped)
+	}
+}
+
+func (s *TaskService) GetRunningTimer(ctx context.Context, token string) (domain.TimeEntry, error) {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task get running timer: invalid token err=%v", err)
+		return domain.TimeEntry{}, ErrInvalidToken
+	}
+
+	entry, err := s.timeEntries.GetRunningByUserID(ctx, userID)
+	if err != nil {
+		logger.Log.Infof("task get running timer: repo error user_id=%d err=%v", userID, err)
+		return domain.TimeEntry{}, err
+	}
+	logger.Log.Infof("task get running timer: success id=%d user_id=%d", entry.ID, userID)
+	return entry, nil
+}
+
+func (s *TaskService) AddTimeEntry(ctx context.Context, token string, taskID int64, startedAt, endedAt time.Time, note string) (domain.TimeEntry, error) {
+	note, ok := normalizeTimeEntryNote(note)
+	if taskID <= 0 || !ok || startedAt.IsZero() || !endedAt.After(startedAt) || endedAt.Sub(startedAt) > domain.MaxTimeEntryDuration {
+		logger.Log.Infof("task add time entry: invalid input task_id=%d", taskID)
+		return domain.TimeEntry{}, ErrInvalidInput
+	}
+	if endedAt.After(s.now()) {
+		logger.Log.Infof("task add time entry: end in the future task_id=%d", taskID)
+		return domain.TimeEntry{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task add time entry: invalid token err=%v", err)
+		return domain.TimeEntry{}, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessStatus); err != nil {
+		logger.Log.Infof("task add time entry: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	entry, err := s.timeEntries.Create(ctx, domain.TimeEntry{
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Note:      note,
+		Manual:    true,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		logger.Log.Infof("task add time entry: repo error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TimeEntry{}, err
+	}
+	logger.Log.Infof("task add time entry: success id=%d task_id=%d user_id=%d", entry.ID, taskID, userID)
+	return entry, nil
+}
+
+// DeleteTimeEntry removes one of the caller's own entries of a task they can
+// still see.
+func (s *TaskService) DeleteTimeEntry(ctx context.Context, token string, taskID, id int64) error {
+	if taskID <= 0 || id <= 0 {
+		logger.Log.Infof("task delete time entry: invalid input task_id=%d id=%d", taskID, id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task delete time entry: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task delete time entry: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return err
+	}
+	if err := s.timeEntries.DeleteByIDAndUserID(ctx, id, taskID, userID); err != nil {
+		logger.Log.Infof("task delete time entry: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("task delete time entry: success id=%d task_id=%d user_id=%d", id, taskID, userID)
+	return nil
+}
+
+// ListTimeEntries returns every entry tracked on a task together with the
+// task total and per-day totals in the caller's time zone.
+func (s *TaskService) ListTimeEntries(ctx context.Context, token string, taskID int64) (domain.TaskTime, error) {
+	if taskID <= 0 {
+		logger.Log.Infof("task list time entries: invalid task_id=%d", taskID)
+		return domain.TaskTime{}, ErrInvalidInput
+	}
+
+	userID, loc, err := s.userLocation(token)
+	if err != nil {
+		logger.Log.Infof("task list time entries: invalid token err=%v", err)
+		return domain.TaskTime{}, ErrInvalidToken
+	}
+
+	if _, err := s.authorizeTask(ctx, taskID, userID, accessRead); err != nil {
+		logger.Log.Infof("task list time entries: access error task_id=%d user_id=%d err=%v", taskID, userID, err)
+		return domain.TaskTime{}, err
+	}
+	entries, err := s.timeEntries.GetByTaskID(ctx, taskID)
+	if err != nil {
+		logger.Log.Infof("task list time entries: repo error task_id=%d err=%v", taskID, err)
+		return domain.TaskTime{}, err
+	}
+
+	now := s.now()
+	byDay := make(map[string]time.Duration)
+	result := domain.TaskTime{Entries: entries}
+	for _, entry := range entries {
+		result.Total += entry.Duration(now)
+		splitByDay(entry.StartedAt, entry.StartedAt.Add(entry.Duration(now)), loc, func(day string, d time.Duration) {
+			byDay[day] += d
+		})
+	}
+	for day, d := range byDay {
+		result.Days = append(result.Days, domain.DailyTime{Day: day, Duration: d})
+	}
+	sort.Slice(result.Days, func(i, j int) bool { return result.Days[i].Day < result.Days[j].Day })
+	logger.Log.Infof("task list time entries: success task_id=%d user_id=%d count=%d", taskID, userID, len(entries))
+	return result, nil
+}
+
+// TimeReport aggregates the caller's tracked time between fromDay and toDay
+// (both inclusive, in the caller's time zone) by project and day. projectID
+// restricts the report to one project the caller is a member of.
+func (s *TaskService) TimeReport(ctx context.Context, token, fromDay, toDay string, projectID int64) (domain.TimeReport, error) {
+	if projectID < 0 {
+		logger.Log.Infof("task time report: invalid project_id=%d", projectID)
+		return domain.TimeReport{}, ErrInvalidInput
+	}
+
+	userID, loc, err := s.userLocation(token)
+	if err != nil {
+		logger.Log.Infof("task time report: invalid token err=%v", err)
+		return domain.TimeReport{}, ErrInvalidToken
+	}
+
+	from, err := time.ParseInLocation(dueDayLayout, fromDay, loc)
+	if err != nil {
+		logger.Log.Infof("task time report: invalid from=%q", fromDay)
+		return domain.TimeReport{}, ErrInvalidInput
+	}
+	to, err := time.ParseInLocation(dueDayLayout, toDay, loc)
+	if err != nil || to.Before(from) || to.After(from.AddDate(0, 0, domain.MaxTimeReportDays-1)) {
+		logger.Log.Infof("task time report: invalid range from=%q to=%q", fromDay, toDay)
+		return domain.TimeReport{}, ErrInvalidInput
+	}
+	to = to.AddDate(0, 0, 1)
+
+	if projectID != 0 {
+		if _, err := s.projects.GetByIDAndMemberID(ctx, projectID, userID); err != nil {
+			logger.Log.Infof("task time report: project error project_id=%d user_id=%d err=%v", projectID, userID, err)
+			return domain.TimeReport{}, err
+		}
+	}
+
+	entries, err := s.timeEntries.GetByUserIDBetween(ctx, userID, projectID, from, to)
+	if err != nil {
+		logger.Log.Infof("task time report: repo error user_id=%d err=%v", userID, err)
+		return domain.TimeReport{}, err
+	}
+
+	type rowKey struct {
+		projectID int64
+		day       string
+	}
+	now := s.now()
+	rows := make(map[rowKey]time.Duration)
+	projects := make(map[int64]time.Duration)
+	report := domain.TimeReport{}
+	for _, entry := range entries {
+		start := maxTime(entry.StartedAt, from)
+		end := minTime(entry.StartedAt.Add(entry.Duration(now)), to)
+		splitByDay(start, end, loc, func(day string, d time.Duration) {
+			rows[rowKey{projectID: entry.ProjectID, day: day}] += d
+			projects[entry.ProjectID] += d
+			report.Total += d
+		})
+	}
+
+	for key, d := range rows {
+		report.Rows = append(report.Rows, domain.ProjectDailyTime{ProjectID: key.projectID, Day: key.day, Duration: d})
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		if report.Rows[i].ProjectID != report.Rows[j].ProjectID {
+			return report.Rows[i].ProjectID < report.Rows[j].ProjectID
+		}
+		return report.Rows[i].Day < report.Rows[j].Day
+	})
+	for id, d := range projects {
+		report.Projects = append(report.Projects, domain.ProjectTime{ProjectID: id, Duration: d})
+	}
+	sort.Slice(report.Projects, func(i, j int) bool { return report.Projects[i].ProjectID < report.Projects[j].ProjectID })
+
+	logger.Log.Infof("task time report: success user_id=%d entries=%d rows=%d", userID, len(entries), len(report.Rows))
+	return report, nil
+}
+
+// splitByDay calls fn for every calendar day in loc that [start, end)
+// overlaps, with the part of the span that falls on that day.
+func splitByDay(start, end time.Time, loc *time.Location, fn func(day string, d time.Duration)) {
+	start = start.In(loc)
+	for start.Before(end) {
+		next := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, loc)
+		if next.After(end) {
+			next = end
+		}
+		fn(start.Format(dueDayLayout), next.Sub(start))
+		start = next
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func normalizeTimeEntryNote(note string) (string, bool) {
+	note = strings.TrimSpace(note)
+	return note, utf8.RuneCountInString(note) <= domain.MaxTimeEntryNoteLength
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"time"
+
+	"task-tracker/pkg/timezone"
+)
+
+const dueDayLayout = "2006-01-02"
+
+// userLocation returns the caller and their time zone. A token without a
+// valid zone falls back to the account default, UTC, never to the zone the
+// service happens to run in.
+func (s *TaskService) userLocation(token string) (int64, *time.Location, error) {
+	userID, timeZone, err := s.tokens.ParseUserIDAndTimeZone(token)
+	if err != nil {
+		return 0, nil, err
+	}
+	return userID, timezone.LoadOrDefault(timeZone, time.UTC), nil
+}
+
+func endOfDay(day string, loc *time.Location) (time.Time, error) {
+	start, err := time.ParseInLocation(dueDayLayout, day, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return start.AddDate(0, 0, 1).Add(-time.Second), nil
+}
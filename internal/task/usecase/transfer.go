@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+const exportPageSize = 500
+
+// ExportTasks writes every task owned by the caller, subtasks included, to w
+// in creation order. A non-zero projectID limits the export to that project.
+// It returns the number of tasks written.
+func (s *TaskService) ExportTasks(ctx context.Context, token string, format domain.TransferFormat, projectID int64, w io.Writer) (int, error) {
+	if projectID < 0 {
+		logger.Log.Infof("task export: invalid project_id=%d", projectID)
+		return 0, ErrInvalidInput
+	}
+	encoder, err := newTaskEncoder(format, w)
+	if err != nil {
+		logger.Log.Infof("task export: invalid format=%d err=%v", format, err)
+		return 0, err
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task export: invalid token err=%v", err)
+		return 0, ErrInvalidToken
+	}
+
+	filter := domain.TaskFilter{UserID: userID, ProjectID: projectID, Sort: domain.SORT_CREATED_AT_ASC, Limit: exportPageSize}
+	count := 0
+	for {
+		tasks, err := s.repo.List(ctx, filter)
+		if err != nil {
+			logger.Log.Infof("task export: repo error user_id=%d err=%v", userID, err)
+			return count, err
+		}
+		for _, task := range tasks {
+			if err := encoder.Encode(task); err != nil {
+				logger.Log.Infof("task export: write error user_id=%d err=%v", userID, err)
+				return count, err
+			}
+			count++
+		}
+		if len(tasks) < exportPageSize {
+			break
+		}
+		cursor := filter.Sort.CursorOf(tasks[len(tasks)-1])
+		filter.After = &cursor
+	}
+	if err := encoder.Flush(); err != nil {
+		logger.Log.Infof("task export: write error user_id=%d err=%v", userID, err)
+		return count, err
+	}
+	logger.Log.Infof("task export: success user_id=%d project_id=%d tasks=%d", userID, projectID, count)
+	return count, nil
+}
+
+// ImportTasks creates the tasks read from r and reports the outcome of every
+// row. Rows that fail validation are skipped; a row matching an existing
+// task or an earlier row by DuplicateKey is reported as a duplicate and not
+// created. parent_id refers to the id column of an earlier row. Rows keep
+// their status, within the rules a task tree follows: a completed task has
+// only completed subtasks, a closed existing task gets no new ones, and an
+// expired task is past due. All tasks are created in one transaction; a dry
+// run validates without creating.
+func (s *TaskService) ImportTasks(ctx context.Context, token string, format domain.TransferFormat, dryRun bool, r io.Reader) (domain.ImportReport, error) {
+	userID, loc, err := s.userLocation(token)
+	if err != nil {
+		logger.Log.Infof("task import: invalid token err=%v", err)
+		return domain.ImportReport{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	records, err := decodeTaskRecords(format, r)
+	if err != nil {
+		logger.Log.Infof("task import: decode error user_id=%d format=%d err=%v", userID, format, err)
+		return domain.ImportReport{}, err
+	}
+
+	descriptions := make([]string, 0, len(records))
+	for _, record := range records {
+		if record.Err == nil {
+			descriptions = append(descriptions, strings.ToLower(strings.TrimSpace(record.Record.Description)))
+		}
+	}
+	existing, err := s.repo.GetByUserIDAndDescriptions(ctx, userID, descriptions)
+	if err != nil {
+		logger.Log.Infof("task import: repo error user_id=%d err=%v", userID, err)
+		return domain.ImportReport{}, err
+	}
+
+	importer := &taskImporter{
+		service:  s,
+		userID:   userID,
+		dryRun:   dryRun,
+		existing: make(map[domain.DuplicateKey]importedTask, len(existing)),
+		seen:     make(map[domain.DuplicateKey]importedTask),
+		rows:     make(map[int64]importedTask),
+		projects: make(map[int64]error),
+	}
+	for _, task := range existing {
+		importer.existing[domain.DuplicateKeyOf(task)] = importedTask{ID: task.ID, ProjectID: task.ProjectID, Status: task.Status, Existing: true}
+	}
+
+	report := domain.ImportReport{DryRun: dryRun}
+	run := func(ctx context.Context) error {
+		for _, record := range records {
+			row, err := importer.importRecord(ctx, record, loc)
+			if err != nil {
+				return err
+			}
+			report.Add(row)
+		}
+		return nil
+	}
+	if dryRun {
+		err = run(ctx)
+	} else {
+		err = s.repo.InTx(ctx, run)
+	}
+	if err != nil {
+		logger.Log.Infof("task import: repo error user_id=%d err=%v", userID, err)
+		return domain.ImportReport{}, err
+	}
+	logger.Log.Infof("task import: success user_id=%d dry_run=%t created=%d duplicates=%d invalid=%d", userID, dryRun, report.Created, report.Duplicates, report.Invalid)
+	return report, nil
+}
+
+// taskImporter carries the state of one import across its rows. On a dry
+// run a row that would be created gets the placeholder id -line so that
+// later rows can still refer to it.
+type taskImporter struct {
+	service  *TaskService
+	userID   int64
+	dryRun   bool
+	existing map[domain.DuplicateKey]importedTask
+	seen     map[domain.DuplicateKey]importedTask
+	rows     map[int64]importedTask
+	projects map[int64]error
+}
+
+// importedTask is the task a row resolved to: created, or the one it
+// duplicates. Existing marks a task that was stored before the import.
+type importedTask struct {
+	Line      int
+	ID        int64
+	ProjectID int64
+	Status    domain.TaskStatus
+	Existing  bool
+}
+
+// importRecord handles one row. It returns an error only when the row could
+// not be stored; problems with the row itself are reported in the result.
+func (i *taskImporter) importRecord(ctx context.Context, record importRecord, loc *time.Location) (domain.ImportRow, error) {
+	invalid := func(err error) domain.ImportRow {
+		return domain.ImportRow{Line: record.Line, Status: domain.IMPORT_INVALID, Error: err.Error()}
+	}
+	if record.Err != nil {
+		return invalid(record.Err), nil
+	}
+	externalID := record.Record.ID
+	if _, ok := i.rows[externalID]; ok && externalID != 0 {
+		return invalid(fmt.Errorf("id %d repeats an earlier row", externalID)), nil
+	}
+
+	draft, err := draftOf(record.Record, loc)
+	if err != nil {
+		return invalid(err), nil
+	}
+	if draft.Status == domain.EXPIRED && draft.DueDate.After(i.service.now()) {
+		return invalid(errors.New("expired task must have a due_date in the past")), nil
+	}
+	var parent importedTask
+	if record.Record.ParentID != 0 {
+		var ok bool
+		parent, ok = i.rows[record.Record.ParentID]
+		if !ok {
+			return invalid(fmt.Errorf("parent_id %d does not match an earlier row", record.Record.ParentID)), nil
+		}
+		if draft.ProjectID != 0 && draft.ProjectID != parent.ProjectID {
+			return invalid(fmt.Errorf("project_id %d differs from the parent task", draft.ProjectID)), nil
+		}
+		draft.ParentID = parent.ID
+		draft.ProjectID = parent.ProjectID
+	} else if draft.ProjectID != 0 {
+		if err := i.checkProject(ctx, draft.ProjectID); err != nil {
+			return invalid(fmt.Errorf("project_id %d: %v", draft.ProjectID, err)), nil
+		}
+	}
+
+	key := domain.DuplicateKeyOf(draft)
+	if task, ok := i.existing[key]; ok {
+		task.Line = record.Line
+		i.remember(externalID, task)
+		return domain.ImportRow{Line: record.Line, Status: domain.IMPORT_DUPLICATE, TaskID: task.ID, Error: "duplicate of an existing task"}, nil
+	}
+	if earlier, ok := i.seen[key]; ok {
+		i.remember(externalID, earlier)
+		row := domain.ImportRow{Line: record.Line, Status: domain.IMPORT_DUPLICATE, Error: fmt.Sprintf("duplicate of line %d", earlier.Line)}
+		if !i.dryRun {
+			row.TaskID = earlier.ID
+		}
+		return row, nil
+	}
+
+	if record.Record.ParentID != 0 {
+		if err := checkImportedParent(parent, draft); err != nil {
+			return invalid(err), nil
+		}
+	}
+
+	created := importedTask{Line: record.Line, ID: -int64(record.Line), ProjectID: draft.ProjectID, Status: draft.Status}
+	row := domain.ImportRow{Line: record.Line, Status: domain.IMPORT_CREATED}
+	if !i.dryRun {
+		draft.UserID = i.userID
+		draft.CreatedAt = i.service.now()
+		task, err := i.service.repo.Create(ctx, draft)
+		if err != nil {
+			return domain.ImportRow{}, err
+		}
+		created.ID = task.ID
+		row.TaskID = task.ID
+	}
+	i.seen[key] = created
+	i.remember(externalID, created)
+	return row, nil
+}
+
+// checkImportedParent applies the rules of AddSubtask to a parent stored
+// before the import, and keeps a completed parent from getting subtasks
+// that are not completed.
+func checkImportedParent(parent importedTask, child domain.Task) error {
+	if parent.Existing && (parent.Status == domain.COMPLETED || parent.Status == domain.EXPIRED) {
+		return fmt.Errorf("parent task is %s and cannot get new subtasks", parent.Status)
+	}
+	if parent.Status == domain.COMPLETED && child.Status != domain.COMPLETED {
+		return errors.New("subtask of a completed task must be completed")
+	}
+	return nil
+}
+
+func (i *taskImporter) remember(externalID int64, task importedTask) {
+	if externalID != 0 {
+		i.rows[externalID] = task
+	}
+}
+
+// checkProject checks the caller may add tasks to projectID, once per
+// project.
+func (i *taskImporter) checkProject(ctx context.Context, projectID int64) error {
+	err, ok := i.projects[projectID]
+	if !ok {
+		err = i.service.checkProject(ctx, projectID, i.userID)
+		i.projects[projectID] = err
+	}
+	return err
+}
@@ -0,0 +1,390 @@
+package usecase
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+// transferColumns are the CSV header and JSON keys of an exported task. An
+// import reads id, parent_id, description, status, priority, due_date, tags,
+// recurrence, reminder_minutes and project_id and ignores the rest.
+var transferColumns = []string{
+	"id", "parent_id", "description", "status", "priority", "due_date", "created_at", "tags",
+	"recurrence", "reminder_minutes", "project_id", "assignee_id", "position", "blocked_by", "board_rank", "version",
+}
+
+// transferListSeparator joins list values inside one CSV cell.
+const transferListSeparator = ";"
+
+const maxImportLineSize = 1 << 20
+
+type taskRecord struct {
+	ID              int64    `json:"id"`
+	ParentID        int64    `json:"parent_id,omitempty"`
+	Description     string   `json:"description"`
+	Status          string   `json:"status,omitempty"`
+	Priority        string   `json:"priority,omitempty"`
+	DueDate         string   `json:"due_date"`
+	CreatedAt       string   `json:"created_at,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Recurrence      string   `json:"recurrence,omitempty"`
+	ReminderMinutes []int64  `json:"reminder_minutes,omitempty"`
+	ProjectID       int64    `json:"project_id,omitempty"`
+	AssigneeID      int64    `json:"assignee_id,omitempty"`
+	Position        int      `json:"position"`
+	BlockedBy       []int64  `json:"blocked_by,omitempty"`
+	BoardRank       string   `json:"board_rank,omitempty"`
+	Version         int64    `json:"version,omitempty"`
+}
+
+func recordOf(task domain.Task) taskRecord {
+	record := taskRecord{
+		ID:          task.ID,
+		ParentID:    task.ParentID,
+		Description: task.Description,
+		Status:      task.Status.String(),
+		Priority:    task.Priority.String(),
+		DueDate:     task.DueDate.UTC().Format(time.RFC3339),
+		CreatedAt:   task.CreatedAt.UTC().Format(time.RFC3339),
+		Tags:        task.Tags,
+		Recurrence:  task.Recurrence,
+		ProjectID:   task.ProjectID,
+		AssigneeID:  task.AssigneeID,
+		Position:    task.Position,
+		BlockedBy:   task.BlockedBy,
+		BoardRank:   task.BoardRank,
+		Version:     task.Version,
+	}
+	for _, reminder := range task.Reminders {
+		record.ReminderMinutes = append(record.ReminderMinutes, int64(reminder/time.Minute))
+	}
+	return record
+}
+
+// taskEncoder writes tasks in one transfer format.
+type taskEncoder interface {
+	Encode(task domain.Task) error
+	Flush() error
+}
+
+func newTaskEncoder(format domain.TransferFormat, w io.Writer) (taskEncoder, error) {
+	switch format {
+	case domain.FORMAT_CSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(transferColumns); err != nil {
+			return nil, err
+		}
+		return &csvTaskEncoder{writer: writer}, nil
+	case domain.FORMAT_JSONL:
+		buffered := bufio.NewWriter(w)
+		return &jsonTaskEncoder{buffered: buffered, encoder: json.NewEncoder(buffered)}, nil
+	default:
+		return nil, ErrInvalidInput
+	}
+}
+
+type csvTaskEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvTaskEncoder) Encode(task domain.Task) error {
+	record := recordOf(task)
+	return e.writer.Write([]string{
+		formatID(record.ID),
+		formatID(record.ParentID),
+		record.Description,
+		record.Status,
+		record.Priority,
+		record.DueDate,
+		record.CreatedAt,
+		strings.Join(record.Tags, transferListSeparator),
+		record.Recurrence,
+		joinInts(record.ReminderMinutes),
+		formatID(record.ProjectID),
+		formatID(record.AssigneeID),
+		strconv.Itoa(record.Position),
+		joinInts(record.BlockedBy),
+		record.BoardRank,
+		formatID(record.Version),
+	})
+}
+
+func (e *csvTaskEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type jsonTaskEncoder struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (e *jsonTaskEncoder) Encode(task domain.Task) error {
+	return e.encoder.Encode(recordOf(task))
+}
+
+func (e *jsonTaskEncoder) Flush() error {
+	return e.buffered.Flush()
+}
+
+// importRecord is one decoded data row. Err is set when the row itself could
+// not be read; the rest of the input is still processed.
+type importRecord struct {
+	Line   int
+	Record taskRecord
+	Err    error
+}
+
+// decodeTaskRecords reads every data row of r. It fails as a whole only when
+// the input cannot be read at all or holds more than MaxImportRows rows.
+func decodeTaskRecords(format domain.TransferFormat, r io.Reader) ([]importRecord, error) {
+	switch format {
+	case domain.FORMAT_CSV:
+		return decodeCSVRecords(r)
+	case domain.FORMAT_JSONL:
+		return decodeJSONRecords(r)
+	default:
+		return nil, ErrInvalidInput
+	}
+}
+
+func decodeCSVRecords(r io.Reader) ([]importRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns["description"]; !ok {
+		return nil, ErrInvalidInput
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if len(records) >= domain.MaxImportRows {
+			return nil, ErrInvalidInput
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			records = append(records, importRecord{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(fields) {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		}
+		record, err := csvRecord(cell)
+		records = append(records, importRecord{Line: line, Record: record, Err: err})
+	}
+}
+
+func csvRecord(cell func(name string) string) (taskRecord, error) {
+	record := taskRecord{
+		Description: cell("description"),
+		Status:      cell("status"),
+		Priority:    cell("priority"),
+		DueDate:     cell("due_date"),
+		Recurrence:  cell("recurrence"),
+	}
+	var err error
+	if record.ID, err = parseID(cell("id")); err != nil {
+		return record, fmt.Errorf("invalid id %q", cell("id"))
+	}
+	if record.ParentID, err = parseID(cell("parent_id")); err != nil {
+		return record, fmt.Errorf("invalid parent_id %q", cell("parent_id"))
+	}
+	if record.ProjectID, err = parseID(cell("project_id")); err != nil {
+		return record, fmt.Errorf("invalid project_id %q", cell("project_id"))
+	}
+	if record.ReminderMinutes, err = splitInts(cell("reminder_minutes")); err != nil {
+		return record, fmt.Errorf("invalid reminder_minutes %q", cell("reminder_minutes"))
+	}
+	for _, tag := range strings.Split(cell("tags"), transferListSeparator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			record.Tags = append(record.Tags, tag)
+		}
+	}
+	return record, nil
+}
+
+func decodeJSONRecords(r io.Reader) ([]importRecord, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	var records []importRecord
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if len(records) >= domain.MaxImportRows {
+			return nil, ErrInvalidInput
+		}
+		var record taskRecord
+		err := json.Unmarshal([]byte(text), &record)
+		if err != nil {
+			err = fmt.Errorf("invalid JSON: %v", err)
+		}
+		records = append(records, importRecord{Line: line, Record: record, Err: err})
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, ErrInvalidInput
+		}
+		return nil, err
+	}
+	return records, nil
+}
+
+// draftOf validates a decoded record and turns it into a task draft. Dates
+// given as a bare day are due at the end of that day in loc.
+func draftOf(record taskRecord, loc *time.Location) (domain.Task, error) {
+	draft := domain.Task{
+		Description: strings.TrimSpace(record.Description),
+		Status:      domain.CREATED,
+		ProjectID:   record.ProjectID,
+	}
+	if draft.Description == "" {
+		return domain.Task{}, errors.New("description is required")
+	}
+
+	if record.Status != "" {
+		status, ok := parseStatusName(record.Status)
+		if !ok {
+			return domain.Task{}, fmt.Errorf("invalid status %q", record.Status)
+		}
+		draft.Status = status
+	}
+	if record.Priority != "" {
+		priority, ok := parsePriorityName(record.Priority)
+		if !ok {
+			return domain.Task{}, fmt.Errorf("invalid priority %q", record.Priority)
+		}
+		draft.Priority = priority
+	}
+
+	switch {
+	case record.DueDate == "":
+		return domain.Task{}, errors.New("due_date is required")
+	case len(record.DueDate) == len(dueDayLayout):
+		due, err := endOfDay(record.DueDate, loc)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("invalid due_date %q", record.DueDate)
+		}
+		draft.DueDate = due
+	default:
+		due, err := time.Parse(time.RFC3339, record.DueDate)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("invalid due_date %q", record.DueDate)
+		}
+		draft.DueDate = due
+	}
+
+	tags, err := domain.NormalizeTags(record.Tags)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("invalid tags: %v", err)
+	}
+	draft.Tags = tags
+	if draft.Recurrence, err = normalizeRecurrence(record.Recurrence); err != nil {
+		return domain.Task{}, fmt.Errorf("invalid recurrence: %v", err)
+	}
+	draft.DueDate = alignToRecurrence(draft.Recurrence, draft.DueDate)
+	reminders := make([]time.Duration, 0, len(record.ReminderMinutes))
+	for _, minutes := range record.ReminderMinutes {
+		reminders = append(reminders, time.Duration(minutes)*time.Minute)
+	}
+	if draft.Reminders, err = domain.NormalizeReminders(reminders); err != nil {
+		return domain.Task{}, fmt.Errorf("invalid reminder_minutes: %v", err)
+	}
+	if record.ParentID != 0 && draft.Recurrence != "" {
+		return domain.Task{}, errors.New("subtasks cannot recur")
+	}
+	return draft, nil
+}
+
+func parseStatusName(name string) (domain.TaskStatus, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, status := range []domain.TaskStatus{domain.CREATED, domain.AT_WORK, domain.COMPLETED, domain.EXPIRED} {
+		if status.String() == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+func parsePriorityName(name string) (domain.Priority, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, priority := range domain.Priorities {
+		if priority.String() == name {
+			return priority, true
+		}
+	}
+	return 0, false
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func parseID(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, ErrInvalidInput
+	}
+	return id, nil
+}
+
+func joinInts(values []int64) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, strconv.FormatInt(value, 10))
+	}
+	return strings.Join(parts, transferListSeparator)
+}
+
+func splitInts(value string) ([]int64, error) {
+	var values []int64
+	for _, part := range strings.Split(value, transferListSeparator) {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"task-tracker/internal/task/domain"
+)
+
+func TestTransferRoundTrip(t *testing.T) {
+	due := time.Date(2026, time.October, 20, 15, 0, 0, 0, time.UTC)
+	created := time.Date(2026, time.October, 1, 9, 0, 0, 0, time.UTC)
+	tasks := []domain.Task{
+		{
+			ID:          11,
+			Description: "Write the \"quarterly\" report,\nthen send it",
+			Status:      domain.AT_WORK,
+			Priority:    domain.HIGH,
+			DueDate:     due,
+			CreatedAt:   created,
+			Tags:        []string{"work", "q4"},
+			Reminders:   []time.Duration{30 * time.Minute, 24 * time.Hour},
+			ProjectID:   7,
+			AssigneeID:  3,
+			BlockedBy:   []int64{12, 13},
+			BoardRank:   "i",
+			Version:     4,
+		},
+		{
+			ID:          12,
+			ParentID:    11,
+			Description: "Collect numbers",
+			Status:      domain.COMPLETED,
+			DueDate:     due.Add(-time.Hour),
+			CreatedAt:   created,
+			ProjectID:   7,
+		},
+		{
+			ID:          13,
+			Description: "Weekly sync; agenda",
+			Status:      domain.CREATED,
+			Priority:    domain.LOW,
+			DueDate:     due.AddDate(0, 0, 2),
+			CreatedAt:   created,
+			Recurrence:  "FREQ=WEEKLY;BYDAY=MO,TH",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		format    domain.TransferFormat
+		wantLines []int
+	}{
+		{name: "csv", format: domain.FORMAT_CSV, wantLines: []int{2, 4, 5}},
+		{name: "jsonl", format: domain.FORMAT_JSONL, wantLines: []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			encoder, err := newTaskEncoder(tt.format, &buf)
+			if err != nil {
+				t.Fatalf("newTaskEncoder: %v", err)
+			}
+			for _, task := range tasks {
+				if err := encoder.Encode(task); err != nil {
+					t.Fatalf("Encode(%d): %v", task.ID, err)
+				}
+			}
+			if err := encoder.Flush(); err != nil {
+				t.Fatalf("Flush: %v", err)
+			}
+
+			records, err := decodeTaskRecords(tt.format, &buf)
+			if err != nil {
+				t.Fatalf("decodeTaskRecords: %v", err)
+			}
+			if len(records) != len(tasks) {
+				t.Fatalf("decoded %d records, want %d", len(records), len(tasks))
+			}
+			for i, record := range records {
+				task := tasks[i]
+				if record.Err != nil {
+					t.Fatalf("record %d: %v", i, record.Err)
+				}
+				if record.Line != tt.wantLines[i] {
+					t.Errorf("record %d line = %d, want %d", i, record.Line, tt.wantLines[i])
+				}
+				if record.Record.ID != task.ID || record.Record.ParentID != task.ParentID {
+					t.Errorf("record %d ids = %d/%d, want %d/%d", i, record.Record.ID, record.Record.ParentID, task.ID, task.ParentID)
+				}
+
+				draft, err := draftOf(record.Record, time.UTC)
+				if err != nil {
+					t.Fatalf("draftOf record %d: %v", i, err)
+				}
+				want := domain.Task{
+					Description: task.Description,
+					Status:      task.Status,
+					Priority:    task.Priority,
+					DueDate:     task.DueDate,
+					Tags:        task.Tags,
+					Recurrence:  task.Recurrence,
+					Reminders:   task.Reminders,
+					ProjectID:   task.ProjectID,
+				}
+				if want.Tags == nil {
+					want.Tags = []string{}
+				}
+				if !draft.DueDate.Equal(want.DueDate) {
+					t.Errorf("record %d due_date = %v, want %v", i, draft.DueDate, want.DueDate)
+				}
+				draft.DueDate = want.DueDate
+				if !reflect.DeepEqual(draft, want) {
+					t.Errorf("record %d draft = %+v, want %+v", i, draft, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeTaskRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  domain.TransferFormat
+		input   string
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "csv header with byte order mark and any column order",
+			format: domain.FORMAT_CSV,
+			input:  "\ufeffDue_Date,Description\n2026-10-20,Pay rent\n",
+			want:   []string{"Pay rent"},
+		},
+		{
+			name:   "csv row with invalid id",
+			format: domain.FORMAT_CSV,
+			input:  "id,description,due_date\nx,Pay rent,2026-10-20\n7,Call bank,2026-10-21\n",
+			want:   []string{`invalid id "x"`, "Call bank"},
+		},
+		{
+			name:    "csv without description column",
+			format:  domain.FORMAT_CSV,
+			input:   "id,due_date\n1,2026-10-20\n",
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:   "jsonl skips blank lines and keeps bad ones",
+			format: domain.FORMAT_JSONL,
+			input:  "{\"description\":\"Pay rent\"}\n\n{not json}\n{\"description\":\"Call bank\"}\n",
+			want:   []string{"Pay rent", "invalid JSON", "Call bank"},
+		},
+		{
+			name:    "unknown format",
+			format:  0,
+			input:   "description\n",
+			wantErr: ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := decodeTaskRecords(tt.format, strings.NewReader(tt.input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodeTaskRecords error = %v, want %v", err, tt.wantErr)
+			}
+			if len(records) != len(tt.want) {
+				t.Fatalf("decoded %d records, want %d", len(records), len(tt.want))
+			}
+			for i, record := range records {
+				got := record.Record.Description
+				if record.Err != nil {
+					got = record.Err.Error()
+				}
+				if !strings.HasPrefix(got, tt.want[i]) {
+					t.Errorf("record %d = %q, want %q", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDraftOf(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	tests := []struct {
+		name    string
+		record  taskRecord
+		wantDue time.Time
+		wantErr string
+	}{
+		{
+			name:    "due day ends in the caller's zone",
+			record:  taskRecord{Description: "Pay rent", DueDate: "2026-10-20"},
+			wantDue: time.Date(2026, time.October, 20, 23, 59, 59, 0, moscow),
+		},
+		{
+			name:    "due timestamp",
+			record:  taskRecord{Description: "Pay rent", DueDate: "2026-10-20T12:00:00Z"},
+			wantDue: time.Date(2026, time.October, 20, 12, 0, 0, 0, time.UTC),
+		},
+		{name: "missing description", record: taskRecord{Description: "  ", DueDate: "2026-10-20"}, wantErr: "description is required"},
+		{name: "missing due date", record: taskRecord{Description: "Pay rent"}, wantErr: "due_date is required"},
+		{name: "invalid due date", record: taskRecord{Description: "Pay rent", DueDate: "20.10.2026"}, wantErr: "invalid due_date"},
+		{name: "invalid status", record: taskRecord{Description: "Pay rent", DueDate: "2026-10-20", Status: "done"}, wantErr: "invalid status"},
+		{name: "invalid priority", record: taskRecord{Description: "Pay rent", DueDate: "2026-10-20", Priority: "asap"}, wantErr: "invalid priority"},
+		{name: "invalid reminder", record: taskRecord{Description: "Pay rent", DueDate: "2026-10-20", ReminderMinutes: []int64{-5}}, wantErr: "invalid reminder_minutes"},
+		{
+			name:    "recurring subtask",
+			record:  taskRecord{ParentID: 1, Description: "Pay rent", DueDate: "2026-10-20", Recurrence: "FREQ=MONTHLY"},
+			wantErr: "subtasks cannot recur",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft, err := draftOf(tt.record, moscow)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("draftOf error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("draftOf: %v", err)
+			}
+			if !draft.DueDate.Equal(tt.wantDue) {
+				t.Fatalf("draftOf due_date = %v, want %v", draft.DueDate, tt.wantDue)
+			}
+		})
+	}
+}
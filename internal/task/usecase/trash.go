@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"task-tracker/internal/task/domain"
+	"task-tracker/pkg/logger"
+)
+
+func (s *TaskService) ListTrash(ctx context.Context, token string) ([]domain.Task, error) {
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task list trash: invalid token err=%v", err)
+		return nil, ErrInvalidToken
+	}
+
+	tasks, err := s.repo.GetDeletedByUserID(ctx, userID)
+	if err != nil {
+		logger.Log.Infof("task list trash: repo error user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	logger.Log.Infof("task list trash: success user_id=%d count=%d", userID, len(tasks))
+	return tasks, nil
+}
+
+func (s *TaskService) Restore(ctx context.Context, token string, id int64) (domain.Task, error) {
+	if id <= 0 {
+		logger.Log.Infof("task restore: invalid id=%d", id)
+		return domain.Task{}, ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task restore: invalid token err=%v", err)
+		return domain.Task{}, ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeDeleted(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task restore: access error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+
+	task, err := s.repo.RestoreByIDAndUserID(ctx, id, current.UserID)
+	if err != nil {
+		logger.Log.Infof("task restore: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return domain.Task{}, err
+	}
+	logger.Log.Infof("task restore: success id=%d user_id=%d", id, userID)
+	return task, nil
+}
+
+func (s *TaskService) Purge(ctx context.Context, token string, id int64) error {
+	if id <= 0 {
+		logger.Log.Infof("task purge: invalid id=%d", id)
+		return ErrInvalidInput
+	}
+
+	userID, err := s.tokens.ParseUserID(token)
+	if err != nil {
+		logger.Log.Infof("task purge: invalid token err=%v", err)
+		return ErrInvalidToken
+	}
+	ctx = domain.WithActor(ctx, domain.UserActor(userID))
+
+	current, err := s.authorizeDeleted(ctx, id, userID, accessWrite)
+	if err != nil {
+		logger.Log.Infof("task purge: access error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+
+	if err := s.repo.PurgeByIDAndUserID(ctx, id, current.UserID); err != nil {
+		logger.Log.Infof("task purge: repo error id=%d user_id=%d err=%v", id, userID, err)
+		return err
+	}
+	logger.Log.Infof("task purge: success id=%d user_id=%d", id, userID)
+	return nil
+}
+
+func (s *TaskService) PurgeTrash(ctx context.Context) error {
+	if s.trashRetention <= 0 {
+		logger.Log.Infof("task purge trash: retention disabled")
+		return nil
+	}
+	ctx = domain.WithActor(ctx, domain.SchedulerActor())
+
+	before := s.now().Add(-s.trashRetention)
+	purged, err := s.repo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		logger.Log.Infof("task purge trash: repo error err=%v", err)
+		return err
+	}
+	logger.Log.Infof("task purge trash: success before=%s count=%d", before.Format(time.RFC3339), purged)
+	return nil
+}
+
+func (s *TaskService) authorizeDeleted(ctx context.Context, id, userID int64, access taskAccess) (domain.Task, error) {
+	task, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	return s.checkAccess(ctx, task, userID, access)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN calendar_feed_token_hash TEXT;
+
+CREATE UNIQUE INDEX users_calendar_feed_token_hash_idx ON users (calendar_feed_token_hash) WHERE calendar_feed_token_hash IS NOT NULL;
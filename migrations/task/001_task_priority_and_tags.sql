@@ -0,0 +1,11 @@
+ALTER TABLE tasks ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+
+CREATE INDEX tasks_user_id_priority_idx ON tasks (user_id, priority);
+
+CREATE TABLE task_tags (
+    task_id BIGINT NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    tag     TEXT   NOT NULL,
+    PRIMARY KEY (task_id, tag)
+);
+
+CREATE INDEX task_tags_tag_idx ON task_tags (tag);
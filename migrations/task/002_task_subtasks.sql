@@ -0,0 +1,4 @@
+ALTER TABLE tasks ADD COLUMN parent_id BIGINT REFERENCES tasks (id) ON DELETE CASCADE;
+ALTER TABLE tasks ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX tasks_parent_id_position_idx ON tasks (parent_id, position);
@@ -0,0 +1,6 @@
+ALTER TABLE tasks ADD COLUMN recurrence TEXT NOT NULL DEFAULT '';
+ALTER TABLE tasks ADD COLUMN series_id BIGINT;
+ALTER TABLE tasks ADD COLUMN occurrence INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN series_start TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX tasks_series_id_occurrence_idx ON tasks (series_id, occurrence) WHERE series_id IS NOT NULL;
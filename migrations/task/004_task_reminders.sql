@@ -0,0 +1,7 @@
+CREATE TABLE task_reminders (
+    task_id        BIGINT NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    offset_seconds BIGINT NOT NULL CHECK (offset_seconds > 0),
+    PRIMARY KEY (task_id, offset_seconds)
+);
+
+CREATE INDEX tasks_status_due_date_idx ON tasks (status, due_date);
@@ -0,0 +1,14 @@
+CREATE TABLE projects (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT      NOT NULL,
+    name       TEXT        NOT NULL,
+    color      TEXT        NOT NULL DEFAULT '',
+    archived   BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX projects_user_id_idx ON projects (user_id, archived);
+
+ALTER TABLE tasks ADD COLUMN project_id BIGINT REFERENCES projects (id) ON DELETE SET NULL;
+
+CREATE INDEX tasks_project_id_idx ON tasks (project_id);
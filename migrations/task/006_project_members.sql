@@ -0,0 +1,16 @@
+CREATE TABLE project_members (
+    project_id BIGINT      NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL,
+    role       SMALLINT    NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX project_members_user_id_idx ON project_members (user_id);
+
+INSERT INTO project_members (project_id, user_id, role, created_at)
+SELECT id, user_id, 0, created_at FROM projects;
+
+ALTER TABLE tasks ADD COLUMN assignee_id BIGINT;
+
+CREATE INDEX tasks_assignee_id_idx ON tasks (assignee_id);
@@ -0,0 +1,13 @@
+CREATE TABLE task_events (
+    id         BIGSERIAL PRIMARY KEY,
+    task_id    BIGINT      NOT NULL,
+    type       SMALLINT    NOT NULL,
+    field      TEXT        NOT NULL DEFAULT '',
+    old_value  TEXT        NOT NULL DEFAULT '',
+    new_value  TEXT        NOT NULL DEFAULT '',
+    actor_id   BIGINT,
+    source     SMALLINT    NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX task_events_task_id_idx ON task_events (task_id, id);
@@ -0,0 +1,12 @@
+CREATE TABLE idempotency_keys (
+    user_id      BIGINT      NOT NULL,
+    key          TEXT        NOT NULL,
+    method       TEXT        NOT NULL,
+    request_hash TEXT        NOT NULL,
+    response     BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,37 @@
+ALTER TABLE tasks ADD COLUMN search_vector TSVECTOR NOT NULL DEFAULT ''::TSVECTOR;
+
+CREATE FUNCTION task_search_vector(task_id BIGINT, description TEXT) RETURNS TSVECTOR AS $$
+    SELECT setweight(to_tsvector('simple', COALESCE(description, '')), 'A') ||
+           setweight(to_tsvector('simple', COALESCE((SELECT string_agg(tag, ' ') FROM task_tags WHERE task_tags.task_id = $1), '')), 'B')
+$$ LANGUAGE sql STABLE;
+
+CREATE FUNCTION tasks_search_vector_refresh() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := task_search_vector(NEW.id, NEW.description);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_search_vector_refresh
+    BEFORE INSERT OR UPDATE OF description ON tasks
+    FOR EACH ROW EXECUTE FUNCTION tasks_search_vector_refresh();
+
+CREATE FUNCTION task_tags_search_vector_refresh() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE tasks SET search_vector = task_search_vector(id, description)
+    WHERE id IN (SELECT task_id FROM changed);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_tags_search_vector_insert
+    AFTER INSERT ON task_tags REFERENCING NEW TABLE AS changed
+    FOR EACH STATEMENT EXECUTE FUNCTION task_tags_search_vector_refresh();
+
+CREATE TRIGGER task_tags_search_vector_delete
+    AFTER DELETE ON task_tags REFERENCING OLD TABLE AS changed
+    FOR EACH STATEMENT EXECUTE FUNCTION task_tags_search_vector_refresh();
+
+UPDATE tasks SET search_vector = task_search_vector(id, description);
+
+CREATE INDEX tasks_search_vector_idx ON tasks USING GIN (search_vector);
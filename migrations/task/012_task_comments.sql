@@ -0,0 +1,30 @@
+CREATE TABLE task_comments (
+    id         BIGSERIAL PRIMARY KEY,
+    task_id    BIGINT      NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL,
+    body       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ
+);
+
+CREATE INDEX task_comments_task_id_id_idx ON task_comments (task_id, id);
+
+CREATE OR REPLACE FUNCTION task_search_vector(task_id BIGINT, description TEXT) RETURNS TSVECTOR AS $$
+    SELECT setweight(to_tsvector('simple', COALESCE(description, '')), 'A') ||
+           setweight(to_tsvector('simple', COALESCE((SELECT string_agg(tag, ' ') FROM task_tags WHERE task_tags.task_id = $1), '')), 'B') ||
+           setweight(to_tsvector('simple', COALESCE((SELECT string_agg(body, ' ') FROM task_comments WHERE task_comments.task_id = $1), '')), 'C')
+$$ LANGUAGE sql STABLE;
+
+ALTER FUNCTION task_tags_search_vector_refresh() RENAME TO task_related_search_vector_refresh;
+
+CREATE TRIGGER task_comments_search_vector_insert
+    AFTER INSERT ON task_comments REFERENCING NEW TABLE AS changed
+    FOR EACH STATEMENT EXECUTE FUNCTION task_related_search_vector_refresh();
+
+CREATE TRIGGER task_comments_search_vector_update
+    AFTER UPDATE ON task_comments REFERENCING NEW TABLE AS changed
+    FOR EACH STATEMENT EXECUTE FUNCTION task_related_search_vector_refresh();
+
+CREATE TRIGGER task_comments_search_vector_delete
+    AFTER DELETE ON task_comments REFERENCING OLD TABLE AS changed
+    FOR EACH STATEMENT EXECUTE FUNCTION task_related_search_vector_refresh();
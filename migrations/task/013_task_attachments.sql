@@ -0,0 +1,13 @@
+CREATE TABLE task_attachments (
+    id           BIGSERIAL PRIMARY KEY,
+    task_id      BIGINT REFERENCES tasks (id) ON DELETE SET NULL,
+    user_id      BIGINT      NOT NULL,
+    file_name    TEXT        NOT NULL,
+    content_type TEXT        NOT NULL,
+    size_bytes   BIGINT      NOT NULL,
+    storage_key  TEXT        NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX task_attachments_task_id_idx ON task_attachments (task_id);
+CREATE INDEX task_attachments_orphaned_idx ON task_attachments (id) WHERE task_id IS NULL;
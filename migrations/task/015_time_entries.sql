@@ -0,0 +1,15 @@
+CREATE TABLE time_entries (
+    id         BIGSERIAL PRIMARY KEY,
+    task_id    BIGINT      NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at   TIMESTAMPTZ,
+    note       TEXT        NOT NULL DEFAULT '',
+    manual     BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE UNIQUE INDEX time_entries_running_user_id_idx ON time_entries (user_id) WHERE ended_at IS NULL;
+CREATE INDEX time_entries_task_id_started_at_idx ON time_entries (task_id, started_at);
+CREATE INDEX time_entries_user_id_started_at_idx ON time_entries (user_id, started_at);
@@ -0,0 +1,6 @@
+ALTER TABLE tasks ADD COLUMN board_rank TEXT COLLATE "C";
+
+CREATE INDEX tasks_project_board_idx ON tasks (project_id, status, board_rank, id)
+    WHERE parent_id IS NULL AND deleted_at IS NULL AND project_id IS NOT NULL;
+CREATE INDEX tasks_personal_board_idx ON tasks (user_id, status, board_rank, id)
+    WHERE parent_id IS NULL AND deleted_at IS NULL AND project_id IS NULL;
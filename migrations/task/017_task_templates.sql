@@ -0,0 +1,26 @@
+CREATE TABLE task_templates (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT      NOT NULL,
+    name       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX task_templates_user_id_idx ON task_templates (user_id, id);
+
+CREATE TABLE task_template_items (
+    id              BIGSERIAL PRIMARY KEY,
+    template_id     BIGINT   NOT NULL REFERENCES task_templates (id) ON DELETE CASCADE,
+    parent_id       BIGINT REFERENCES task_template_items (id) ON DELETE CASCADE,
+    position        INTEGER  NOT NULL,
+    description     TEXT     NOT NULL,
+    due_offset_days INTEGER  NOT NULL DEFAULT 0,
+    priority        SMALLINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX task_template_items_template_id_idx ON task_template_items (template_id, position);
+
+CREATE TABLE task_template_item_tags (
+    item_id BIGINT NOT NULL REFERENCES task_template_items (id) ON DELETE CASCADE,
+    tag     TEXT   NOT NULL,
+    PRIMARY KEY (item_id, tag)
+);
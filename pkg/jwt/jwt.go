@@ -15,7 +15,8 @@ var (
 
 type Claims struct {
 	jwtsdk.RegisteredClaims
-	Email string `json:"email"`
+	Email    string `json:"email"`
+	TimeZone string `json:"tz,omitempty"`
 }
 
 type Manager struct {
@@ -23,7 +24,7 @@ type Manager struct {
 	TTL    time.Duration
 }
 
-func (m Manager) NewToken(userID int64, email string) (string, error) {
+func (m Manager) NewToken(userID int64, email, timeZone string) (string, error) {
 	now := time.Now()
 	claims := Claims{
 		RegisteredClaims: jwtsdk.RegisteredClaims{
@@ -33,18 +34,28 @@ func (m Manager) NewToken(userID int64, email string) (string, error) {
 			ExpiresAt: jwtsdk.NewNumericDate(now.Add(m.TTL)),
 			ID:        strconv.FormatInt(userID, 10),
 		},
-		Email: email,
+		Email:    email,
+		TimeZone: timeZone,
 	}
 
 	token := jwtsdk.NewWithClaims(jwtsdk.SigningMethodHS256, claims)
 	return token.SignedString(m.Secret)
 }
 
+func (m Manager) ParseUserID(token string) (int64, error) {
+	return Parser{Secret: m.Secret}.ParseUserID(token)
+}
+
 type Parser struct {
 	Secret []byte
 }
 
 func (p Parser) ParseUserID(token string) (int64, error) {
+	userID, _, err := p.ParseUserIDAndTimeZone(token)
+	return userID, err
+}
+
+func (p Parser) ParseUserIDAndTimeZone(token string) (int64, string, error) {
 	parsed, err := jwtsdk.ParseWithClaims(token, &Claims{}, func(t *jwtsdk.Token) (interface{}, error) {
 		if _, ok := t.Method.(*jwtsdk.SigningMethodHMAC); !ok {
 			return nil, ErrUnexpectedSigningAlgo
@@ -52,23 +63,23 @@ func (p Parser) ParseUserID(token string) (int64, error) {
 		return p.Secret, nil
 	})
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
 	claims, ok := parsed.Claims.(*Claims)
 	if !ok || !parsed.Valid {
-		return 0, ErrInvalidToken
+		return 0, "", ErrInvalidToken
 	}
 	if claims.Subject != "user" || claims.Issuer != "task-tracker" {
-		return 0, ErrInvalidToken
+		return 0, "", ErrInvalidToken
 	}
 	if claims.ID == "" {
-		return 0, ErrInvalidToken
+		return 0, "", ErrInvalidToken
 	}
 
 	userID, err := strconv.ParseInt(claims.ID, 10, 64)
 	if err != nil {
-		return 0, ErrInvalidToken
+		return 0, "", ErrInvalidToken
 	}
-	return userID, nil
+	return userID, claims.TimeZone, nil
 }
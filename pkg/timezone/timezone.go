@@ -0,0 +1,34 @@
+package timezone
+
+import (
+	"errors"
+	"time"
+	_ "time/tzdata"
+)
+
+const Default = "UTC"
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidTimeZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+func LoadOrDefault(name string, fallback *time.Location) *time.Location {
+	loc, err := Load(name)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}